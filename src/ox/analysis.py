@@ -0,0 +1,1352 @@
+"""Cross-session analysis helpers shared by plugins.
+
+These work directly on TrainingLog (no SQL), so they are also usable from
+library code and tests without building a database.
+"""
+
+from dataclasses import dataclass
+from datetime import date, timedelta
+from typing import Optional
+
+from ox.builtins.e1rm import one_rep_max
+from ox.data import TrainingLog, TrainingSession, TrainingSet
+from ox.units import compare_weights
+
+
+@dataclass(frozen=True, slots=True)
+class Plateau:
+    """A movement whose estimated 1RM has stopped improving.
+
+    Attributes:
+        movement: Movement name
+        window_start: First session date inside the stale window
+        window_end: Most recent session date for the movement
+        last_improvement: Date the all-time best e1RM last went up
+        best_e1rm_kg: The best e1RM reached so far, in kilograms
+        sessions_in_window: Number of sessions inside the window
+    """
+
+    movement: str
+    window_start: date
+    window_end: date
+    last_improvement: Optional[date]
+    best_e1rm_kg: float
+    sessions_in_window: int
+
+
+def session_best_e1rms(
+    log: TrainingLog,
+    movement: Optional[str] = None,
+    stats: Optional["LogStats"] = None,
+) -> dict[str, list[tuple[date, float]]]:
+    """Best estimated 1RM (Brzycki, kg) per completed session per movement.
+
+    Bodyweight sets without a resolved weight, sets marked warmup, and
+    open-ended AMRAP sets (no achieved reps logged yet) are skipped.
+    Sessions where a movement has no weighted working sets produce no
+    entry for it.
+
+    Args:
+        log: Training log
+        movement: Restrict to one movement (None for all)
+        stats: Precomputed LogStats to answer from instead of rescanning
+
+    Returns:
+        Dict of movement name to chronological (date, e1rm_kg) pairs
+    """
+    if stats is not None:
+        if movement is not None:
+            pairs = stats.e1rm_history.get(movement)
+            return {movement: list(pairs)} if pairs else {}
+        return {name: list(pairs) for name, pairs in stats.e1rm_history.items()}
+
+    history: dict[str, list[tuple[date, float]]] = {}
+    for session in sorted(log.completed_sessions, key=lambda s: s.date):
+        for m in session.movements:
+            if movement is not None and m.name != movement:
+                continue
+            e1rms = [
+                one_rep_max(s.weight_kg, s.reps)
+                for s in m.sets
+                if s.weight_kg is not None and not s.warmup and s.reps >= 1
+            ]
+            if e1rms:
+                history.setdefault(m.name, []).append((session.date, max(e1rms)))
+    return history
+
+
+@dataclass(frozen=True, slots=True)
+class LogStats:
+    """Indexed summary of a log, built once and shared across analyses.
+
+    Analysis helpers that walk every session accept one of these (via a
+    `stats` argument) to avoid rescanning a large log for each call.
+    Build it with compute_stats; it reflects the log at that moment and
+    must be rebuilt after a reload.
+
+    Attributes:
+        sets_by_movement: Movement name to chronological (date, set)
+            pairs from completed sessions, warmups included
+        e1rm_history: Movement name to chronological (date, e1rm_kg)
+            pairs, as session_best_e1rms computes them
+        movements_by_tag: Definition tag to the movement names carrying it
+    """
+
+    sets_by_movement: dict[str, tuple[tuple[date, TrainingSet], ...]]
+    e1rm_history: dict[str, tuple[tuple[date, float], ...]]
+    movements_by_tag: dict[str, tuple[str, ...]]
+
+
+def compute_stats(log: TrainingLog) -> LogStats:
+    """Build the shared analysis indexes in one pass over the log.
+
+    One sort of the completed sessions (O(n log n) in sessions) followed
+    by a single linear walk of every set; memory is proportional to the
+    log itself.
+
+    Args:
+        log: Training log
+
+    Returns:
+        LogStats ready to pass to stats-aware analysis helpers
+    """
+    sets_by_movement: dict[str, list[tuple[date, TrainingSet]]] = {}
+    e1rm_history: dict[str, list[tuple[date, float]]] = {}
+    for session in sorted(log.completed_sessions, key=lambda s: s.date):
+        for m in session.movements:
+            by_movement = sets_by_movement.setdefault(m.name, [])
+            e1rms = []
+            for s in m.sets:
+                by_movement.append((session.date, s))
+                if s.weight_kg is not None and not s.warmup and s.reps >= 1:
+                    e1rms.append(one_rep_max(s.weight_kg, s.reps))
+            if e1rms:
+                e1rm_history.setdefault(m.name, []).append((session.date, max(e1rms)))
+
+    movements_by_tag: dict[str, list[str]] = {}
+    for definition in log.movement_definitions:
+        for tag in definition.tags:
+            movements_by_tag.setdefault(tag, []).append(definition.name)
+
+    return LogStats(
+        sets_by_movement={k: tuple(v) for k, v in sets_by_movement.items()},
+        e1rm_history={k: tuple(v) for k, v in e1rm_history.items()},
+        movements_by_tag={k: tuple(v) for k, v in movements_by_tag.items()},
+    )
+
+
+def average_intensity(
+    session: TrainingSession,
+    log: TrainingLog,
+    stats: Optional[LogStats] = None,
+) -> dict[str, float]:
+    """Average working weight per movement as a percent of prior best e1RM.
+
+    The baseline is each movement's best estimated 1RM from sessions
+    strictly before the session's date — later sessions never leak into
+    it. Movements with no weighted sets in the session, or no established
+    e1RM beforehand, are skipped. High percentages mark a heavy day, low
+    ones a volume day.
+
+    Args:
+        session: The session to assess
+        log: Full training log providing the e1RM history
+        stats: Precomputed LogStats to avoid rescanning the log
+
+    Returns:
+        Dict of movement name to intensity percent (0-100 scale)
+    """
+    history = session_best_e1rms(log, stats=stats)
+    intensities = {}
+    for m in session.movements:
+        kgs = [s.weight_kg for s in m.sets if s.weight_kg is not None and not s.warmup]
+        if not kgs:
+            continue
+        prior = [e for d, e in history.get(m.name, []) if d < session.date]
+        if not prior:
+            continue
+        intensities[m.name] = 100 * (sum(kgs) / len(kgs)) / max(prior)
+    return intensities
+
+
+def e1rm_trend(
+    log: TrainingLog,
+    movement: str,
+    stats: Optional[LogStats] = None,
+) -> tuple[float, float, list[tuple[date, float]]]:
+    """Linear trend of a movement's per-session best estimated 1RM.
+
+    Fits ordinary least squares against actual elapsed days (not session
+    index), so uneven training gaps don't distort the slope. The slope is
+    reported per week — kg/week of progress — and r² says how well the
+    line explains the data (1.0 for perfectly flat data, which the line
+    fits exactly).
+
+    Args:
+        log: Training log
+        movement: Movement name
+        stats: Precomputed LogStats to avoid rescanning the log
+
+    Returns:
+        (slope_kg_per_week, r_squared, chronological (date, e1rm_kg) points)
+
+    Raises:
+        ValueError: If the movement has fewer than three data points
+    """
+    points = session_best_e1rms(log, movement=movement, stats=stats).get(movement, [])
+    if len(points) < 3:
+        raise ValueError(
+            f"Need at least 3 sessions with weighted sets for '{movement}', "
+            f"got {len(points)}"
+        )
+
+    first = points[0][0]
+    xs = [(d - first).days for d, _ in points]
+    ys = [e for _, e in points]
+    n = len(points)
+    mean_x = sum(xs) / n
+    mean_y = sum(ys) / n
+    ss_xx = sum((x - mean_x) ** 2 for x in xs)
+    slope_per_day = (
+        sum((x - mean_x) * (y - mean_y) for x, y in zip(xs, ys)) / ss_xx
+        if ss_xx
+        else 0.0
+    )
+    intercept = mean_y - slope_per_day * mean_x
+
+    ss_res = sum((y - (intercept + slope_per_day * x)) ** 2 for x, y in zip(xs, ys))
+    ss_tot = sum((y - mean_y) ** 2 for y in ys)
+    r_squared = 1.0 - ss_res / ss_tot if ss_tot else 1.0
+
+    return slope_per_day * 7, r_squared, points
+
+
+def density(session: TrainingSession) -> Optional[float]:
+    """Work-to-rest density of a session, in reps per minute of rest.
+
+    Only sets with a recorded rest annotation participate; sets without
+    one are excluded entirely rather than counted as zero rest. Returns
+    None when the session has no rest data at all.
+
+    Args:
+        session: A training session
+
+    Returns:
+        Reps per minute of recorded rest, or None
+    """
+    reps = 0
+    rest_seconds = 0.0
+    for m in session.movements:
+        for s in m.sets:
+            if s.rest is None:
+                continue
+            reps += s.reps
+            rest_seconds += s.rest.total_seconds()
+    if rest_seconds == 0:
+        return None
+    return reps / (rest_seconds / 60)
+
+
+def time_under_tension(training_set: TrainingSet) -> Optional[timedelta]:
+    """Estimate a set's time under tension from its tempo annotation.
+
+    One rep takes the sum of the four tempo phases
+    (eccentric-pause-concentric-pause); the set's TUT is that times its
+    reps. Returns None when the set has no tempo recorded, so callers can
+    distinguish "no tempo" from an actual zero.
+
+    Args:
+        training_set: A single set
+
+    Returns:
+        Total time under tension, or None without a tempo
+    """
+    if training_set.tempo is None:
+        return None
+    return timedelta(seconds=sum(training_set.tempo) * training_set.reps)
+
+
+def session_time_under_tension(session: TrainingSession) -> Optional[timedelta]:
+    """Total time under tension across a session's tempo-annotated sets.
+
+    Sets without a tempo are excluded rather than counted as zero.
+    Returns None when no set in the session has a tempo.
+
+    Args:
+        session: A training session
+
+    Returns:
+        Summed time under tension, or None without any tempo data
+    """
+    total = timedelta()
+    found = False
+    for m in session.movements:
+        for s in m.sets:
+            tut = time_under_tension(s)
+            if tut is not None:
+                total += tut
+                found = True
+    return total if found else None
+
+
+@dataclass(frozen=True, slots=True)
+class DeloadOptions:
+    """Thresholds for recommend_deload.
+
+    Attributes:
+        window_weeks: Length of the recent period; it is compared against
+            the same number of weeks immediately before it
+        tonnage_rise: Recent/baseline weekly tonnage ratio at or above
+            which tonnage counts as rising
+        min_e1rm_gain_kg: A movement must beat its baseline best e1RM by
+            more than this for progress to count
+        rpe_rise: Recent average RPE must exceed the baseline average by
+            at least this for RPE to count as climbing
+    """
+
+    window_weeks: int = 4
+    tonnage_rise: float = 1.10
+    min_e1rm_gain_kg: float = 1.0
+    rpe_rise: float = 0.5
+
+
+def _week_of(d: date) -> date:
+    """Sunday starting the week containing d (matches the SQL weekly bin)."""
+    return d - timedelta(days=(d.weekday() + 1) % 7)
+
+
+def recommend_deload(
+    log: TrainingLog, opts: DeloadOptions = DeloadOptions()
+) -> tuple[bool, str]:
+    """Suggest whether a deload week is advisable, with the reasoning.
+
+    A deload is recommended when weekly tonnage is rising while no
+    movement's estimated 1RM is improving — working harder for nothing —
+    and average RPE is climbing. Without any RPE data the RPE signal is
+    skipped and the reason says so.
+
+    Args:
+        log: Training log
+        opts: Thresholds; see DeloadOptions
+
+    Returns:
+        (recommended, human-readable reason)
+    """
+    sessions = sorted(log.completed_sessions, key=lambda s: s.date)
+    if not sessions:
+        return False, "No completed sessions to assess."
+    last_week = _week_of(sessions[-1].date)
+    recent_start = last_week - timedelta(weeks=opts.window_weeks - 1)
+    baseline_start = recent_start - timedelta(weeks=opts.window_weeks)
+    if _week_of(sessions[0].date) > baseline_start:
+        return False, (
+            f"Not enough history: need {2 * opts.window_weeks} weeks of "
+            "training to compare against a baseline."
+        )
+
+    def period(session) -> Optional[str]:
+        week = _week_of(session.date)
+        if week >= recent_start:
+            return "recent"
+        if week >= baseline_start:
+            return "baseline"
+        return None
+
+    tonnage = {"recent": 0.0, "baseline": 0.0}
+    rpes: dict[str, list[float]] = {"recent": [], "baseline": []}
+    for session in sessions:
+        p = period(session)
+        if p is None:
+            continue
+        for m in session.movements:
+            for s in m.sets:
+                if s.weight_kg is not None:
+                    tonnage[p] += s.weight_kg * s.reps
+                if s.rpe is not None:
+                    rpes[p].append(s.rpe)
+
+    weekly_recent = tonnage["recent"] / opts.window_weeks
+    weekly_baseline = tonnage["baseline"] / opts.window_weeks
+    if weekly_baseline == 0:
+        return False, "No weighted baseline tonnage to compare against."
+    ratio = weekly_recent / weekly_baseline
+    tonnage_rising = ratio >= opts.tonnage_rise
+
+    best_gain = None
+    for history in session_best_e1rms(log).values():
+        baseline_best = max(
+            (e for d, e in history if _week_of(d) < recent_start), default=None
+        )
+        recent_best = max(
+            (e for d, e in history if _week_of(d) >= recent_start), default=None
+        )
+        if baseline_best is None or recent_best is None:
+            continue
+        gain = recent_best - baseline_best
+        if best_gain is None or gain > best_gain:
+            best_gain = gain
+    progressing = best_gain is not None and best_gain > opts.min_e1rm_gain_kg
+
+    have_rpe = bool(rpes["recent"]) and bool(rpes["baseline"])
+    rpe_climbing = False
+    rpe_delta = 0.0
+    if have_rpe:
+        rpe_delta = sum(rpes["recent"]) / len(rpes["recent"]) - sum(
+            rpes["baseline"]
+        ) / len(rpes["baseline"])
+        rpe_climbing = rpe_delta >= opts.rpe_rise
+
+    tonnage_part = (
+        f"weekly tonnage {'up' if ratio >= 1 else 'down'} "
+        f"{abs(ratio - 1):.0%} vs the prior {opts.window_weeks} weeks"
+    )
+    progress_part = (
+        f"best e1RM up {best_gain:.1f}kg"
+        if progressing
+        else "no movement's e1RM is improving"
+    )
+    if have_rpe:
+        rpe_part = f"average RPE {rpe_delta:+.1f}"
+    else:
+        rpe_part = "no RPE data; based on tonnage vs progress only"
+
+    reason = f"{tonnage_part}; {progress_part}; {rpe_part}."
+    recommended = tonnage_rising and not progressing and (rpe_climbing or not have_rpe)
+    if recommended:
+        return True, f"Deload advisable: {reason}"
+    return False, f"No deload needed: {reason}"
+
+
+def weekly_sets_by_tag(
+    log: TrainingLog,
+    start: Optional[date] = None,
+    end: Optional[date] = None,
+) -> dict[str, float]:
+    """Average weekly set counts per movement tag.
+
+    Counts sets (not tonnage) from completed sessions, which is the usual
+    currency for hypertrophy tracking. A set counts toward every tag its
+    movement's @movement definition carries, so a squat tagged
+    "quad, knee-dominant" contributes to both. Movements without a
+    definition contribute to no tag.
+
+    Args:
+        log: Training log
+        start: Earliest session date (inclusive; default first session)
+        end: Latest session date (inclusive; default last session)
+
+    Returns:
+        Dict of tag to average sets per week over the date range
+    """
+    tags_for = {d.name: d.tags for d in log.movement_definitions}
+    sessions = log.filter(start=start, end=end)
+    counted = [s for s in sessions if s.flag == "*"]
+    if not counted:
+        return {}
+
+    totals: dict[str, int] = {}
+    for session in counted:
+        for m in session.movements:
+            working = sum(1 for s in m.sets if not s.warmup)
+            for tag in tags_for.get(m.name, ()):
+                totals[tag] = totals.get(tag, 0) + working
+
+    range_start = start if start is not None else counted[0].date
+    range_end = end if end is not None else counted[-1].date
+    weeks = (range_end - range_start).days // 7 + 1
+    return {tag: count / weeks for tag, count in totals.items()}
+
+
+def low_volume_tags(
+    log: TrainingLog,
+    minimum: float = 10.0,
+    start: Optional[date] = None,
+    end: Optional[date] = None,
+) -> list[tuple[str, float]]:
+    """Flag tags trained below a minimum weekly set count.
+
+    Every tag that appears in a movement definition is checked, so a tag
+    with no sets at all in the range is flagged at 0.0.
+
+    Args:
+        log: Training log
+        minimum: Weekly sets below this are flagged
+        start: Earliest session date (inclusive)
+        end: Latest session date (inclusive)
+
+    Returns:
+        (tag, weekly_sets) pairs below the minimum, sorted by tag
+    """
+    weekly = weekly_sets_by_tag(log, start=start, end=end)
+    all_tags = {tag for d in log.movement_definitions for tag in d.tags}
+    return sorted(
+        (tag, weekly.get(tag, 0.0))
+        for tag in all_tags
+        if weekly.get(tag, 0.0) < minimum
+    )
+
+
+def detect_plateaus(
+    log: TrainingLog,
+    window_days: int = 28,
+    min_gain_kg: float = 1.0,
+    min_sessions: int = 3,
+    stats: Optional[LogStats] = None,
+) -> list[Plateau]:
+    """Flag movements whose best e1RM has stalled.
+
+    A movement is plateaued when its best e1RM inside the trailing window
+    (ending at its most recent session) is no more than min_gain_kg above
+    the best achieved before the window. Movements trained fewer than
+    min_sessions times in the window are skipped to avoid false positives.
+
+    Args:
+        log: Training log
+        window_days: Length of the trailing window
+        min_gain_kg: Improvement below this counts as a stall
+        min_sessions: Minimum sessions in the window to consider
+        stats: Precomputed LogStats to avoid rescanning the log
+
+    Returns:
+        Plateaus sorted by movement name
+    """
+    plateaus = []
+    for movement, history in sorted(session_best_e1rms(log, stats=stats).items()):
+        window_end = history[-1][0]
+        window_start = window_end - timedelta(days=window_days)
+        in_window = [(d, e) for d, e in history if d > window_start]
+        before = [e for d, e in history if d <= window_start]
+        if len(in_window) < min_sessions or not before:
+            continue
+        baseline = max(before)
+        window_best = max(e for _, e in in_window)
+        if window_best > baseline + min_gain_kg:
+            continue
+
+        best = 0.0
+        last_improvement = None
+        for d, e in history:
+            if e > best + (min_gain_kg if best else 0):
+                best = e
+                last_improvement = d
+
+        plateaus.append(
+            Plateau(
+                movement=movement,
+                window_start=in_window[0][0],
+                window_end=window_end,
+                last_improvement=last_improvement,
+                best_e1rm_kg=round(best, 1),
+                sessions_in_window=len(in_window),
+            )
+        )
+    return plateaus
+
+
+@dataclass(frozen=True, slots=True)
+class Consistency:
+    """Training consistency metrics over a date range.
+
+    Attributes:
+        training_days: Calendar days with at least one completed session
+        longest_streak: Most training days in any run of consecutive
+            active days (trained or marked rest)
+        current_streak: Training days in the run ending at the range end;
+            0 when the range ends on an unmarked gap
+        avg_sessions_per_week: Completed sessions per week over the range
+    """
+
+    training_days: int
+    longest_streak: int
+    current_streak: int
+    avg_sessions_per_week: float
+
+
+def consistency(
+    log: TrainingLog,
+    start: Optional[date] = None,
+    end: Optional[date] = None,
+) -> Consistency:
+    """Compute streak and consistency metrics from completed sessions.
+
+    A streak is consecutive calendar days with at least one session.
+    Marked rest days (``2025-01-12 rest``) are intentional and keep a
+    streak alive without counting as training days; unmarked gaps break
+    it.
+
+    Args:
+        log: Training log
+        start: Range start (inclusive; default first completed session)
+        end: Range end (inclusive; default last completed session).
+            The current streak is measured as of this day
+
+    Returns:
+        Consistency metrics; all zeros when no sessions fall in range
+    """
+    trained = {s.date for s in log.completed_sessions}
+    if start is not None:
+        trained = {d for d in trained if d >= start}
+    if end is not None:
+        trained = {d for d in trained if d <= end}
+    if not trained:
+        return Consistency(0, 0, 0, 0.0)
+
+    range_start = start if start is not None else min(trained)
+    range_end = end if end is not None else max(trained)
+    rested = {r.date for r in log.rest_days if range_start <= r.date <= range_end}
+
+    def streak_ending(day: date) -> int:
+        count = 0
+        while day >= range_start and (day in trained or day in rested):
+            if day in trained:
+                count += 1
+            day -= timedelta(days=1)
+        return count
+
+    longest = max(streak_ending(d) for d in trained)
+    weeks = (range_end - range_start).days // 7 + 1
+    return Consistency(
+        training_days=len(trained),
+        longest_streak=longest,
+        current_streak=streak_ending(range_end),
+        avg_sessions_per_week=round(
+            len(
+                [
+                    s
+                    for s in log.completed_sessions
+                    if range_start <= s.date <= range_end
+                ]
+            )
+            / weeks,
+            2,
+        ),
+    )
+
+
+@dataclass(frozen=True, slots=True)
+class MovementComparison:
+    """Best e1RM change for one movement between two compared periods.
+
+    Attributes:
+        movement: Movement name
+        best_a_kg: Best e1RM in the first period (None when absent)
+        best_b_kg: Best e1RM in the second period (None when absent)
+        delta_kg: Absolute change (None unless present in both)
+        delta_pct: Percent change (None unless present in both)
+        status: "common", "added" (only in b), or "dropped" (only in a)
+    """
+
+    movement: str
+    best_a_kg: Optional[float]
+    best_b_kg: Optional[float]
+    delta_kg: Optional[float]
+    delta_pct: Optional[float]
+    status: str
+
+
+@dataclass(frozen=True, slots=True)
+class CompareReport:
+    """Contrast of two date ranges, structured for table rendering.
+
+    Attributes:
+        tonnage_a_kg: Total tonnage in the first period
+        tonnage_b_kg: Total tonnage in the second period
+        sets_a: Set count in the first period
+        sets_b: Set count in the second period
+        avg_intensity_a_kg: Mean kg per rep of weighted sets (None when
+            the period has no weighted reps)
+        avg_intensity_b_kg: Same for the second period
+        movements: Per-movement best e1RM comparisons, sorted by name
+    """
+
+    tonnage_a_kg: float
+    tonnage_b_kg: float
+    sets_a: int
+    sets_b: int
+    avg_intensity_a_kg: Optional[float]
+    avg_intensity_b_kg: Optional[float]
+    movements: tuple[MovementComparison, ...]
+
+
+def percent_change(before: Optional[float], after: Optional[float]) -> Optional[float]:
+    """Percent change from before to after; None when undefined."""
+    if before is None or after is None or before == 0:
+        return None
+    return round((after - before) / before * 100, 1)
+
+
+def _period_stats(
+    log: TrainingLog, start: date, end: date
+) -> tuple[float, int, Optional[float], dict[str, float]]:
+    """Tonnage, set count, avg intensity, and best e1RM per movement."""
+    tonnage = 0.0
+    sets = 0
+    weighted_reps = 0
+    best: dict[str, float] = {}
+    for session in log.filter(start=start, end=end):
+        if session.flag != "*":
+            continue
+        for m in session.movements:
+            for s in m.sets:
+                if s.warmup:
+                    continue
+                sets += 1
+                if s.weight_kg is None:
+                    continue
+                tonnage += s.weight_kg * s.reps
+                weighted_reps += s.reps
+                if s.reps >= 1:
+                    e1rm = one_rep_max(s.weight_kg, s.reps)
+                    best[m.name] = max(best.get(m.name, 0.0), e1rm)
+    avg = round(tonnage / weighted_reps, 1) if weighted_reps else None
+    return round(tonnage, 1), sets, avg, best
+
+
+def compare(
+    log: TrainingLog,
+    a_start: date,
+    a_end: date,
+    b_start: date,
+    b_end: date,
+) -> CompareReport:
+    """Contrast two date ranges of completed training.
+
+    Compares tonnage, set count, and average intensity (kg per weighted
+    rep) between the periods, plus the best estimated 1RM per movement.
+    Movements trained in only one period are flagged as added or dropped.
+
+    Args:
+        log: Training log
+        a_start: First period start (inclusive)
+        a_end: First period end (inclusive)
+        b_start: Second period start (inclusive)
+        b_end: Second period end (inclusive)
+
+    Returns:
+        CompareReport with per-movement rows sorted by name
+    """
+    tonnage_a, sets_a, avg_a, best_a = _period_stats(log, a_start, a_end)
+    tonnage_b, sets_b, avg_b, best_b = _period_stats(log, b_start, b_end)
+
+    movements = []
+    for name in sorted(set(best_a) | set(best_b)):
+        a = round(best_a[name], 1) if name in best_a else None
+        b = round(best_b[name], 1) if name in best_b else None
+        if a is not None and b is not None:
+            status = "common"
+            delta = round(b - a, 1)
+        else:
+            status = "added" if a is None else "dropped"
+            delta = None
+        movements.append(
+            MovementComparison(
+                movement=name,
+                best_a_kg=a,
+                best_b_kg=b,
+                delta_kg=delta,
+                delta_pct=percent_change(a, b) if status == "common" else None,
+                status=status,
+            )
+        )
+
+    return CompareReport(
+        tonnage_a_kg=tonnage_a,
+        tonnage_b_kg=tonnage_b,
+        sets_a=sets_a,
+        sets_b=sets_b,
+        avg_intensity_a_kg=avg_a,
+        avg_intensity_b_kg=avg_b,
+        movements=tuple(movements),
+    )
+
+
+@dataclass(frozen=True, slots=True)
+class MovementDiff:
+    """Planned-vs-actual difference for one movement.
+
+    Attributes:
+        movement: Movement name
+        planned_sets: Sets programmed
+        actual_sets: Sets logged
+        missed_sets: Sets programmed but not done
+        extra_sets: Sets done beyond the plan
+        weight_delta_kg: Mean actual minus mean planned working weight
+            (None when either side has no weighted sets)
+    """
+
+    movement: str
+    planned_sets: int
+    actual_sets: int
+    missed_sets: int
+    extra_sets: int
+    weight_delta_kg: Optional[float]
+
+
+@dataclass(frozen=True, slots=True)
+class DiffResult:
+    """Plan-vs-actual comparison for one date.
+
+    Machine-readable via the fields; printable via to_text().
+
+    Attributes:
+        date: The compared date
+        movements: Per-movement diffs for movements in both sessions
+        missed_movements: Planned but never performed
+        extra_movements: Performed but never planned
+    """
+
+    date: date
+    movements: tuple[MovementDiff, ...]
+    missed_movements: tuple[str, ...]
+    extra_movements: tuple[str, ...]
+
+    def to_text(self) -> str:
+        """Render a human-readable adherence summary."""
+        lines = [f"Plan vs actual for {self.date.isoformat()}"]
+        for m in self.movements:
+            bits = [f"{m.actual_sets}/{m.planned_sets} sets"]
+            if m.missed_sets:
+                bits.append(f"{m.missed_sets} missed")
+            if m.extra_sets:
+                bits.append(f"{m.extra_sets} extra")
+            if m.weight_delta_kg:
+                bits.append(f"weight {m.weight_delta_kg:+g}kg")
+            lines.append(f"  {m.movement}: " + ", ".join(bits))
+        if self.missed_movements:
+            lines.append("Missed entirely: " + ", ".join(self.missed_movements))
+        if self.extra_movements:
+            lines.append("Not planned: " + ", ".join(self.extra_movements))
+        if len(lines) == 1:
+            lines.append("  (nothing to compare)")
+        return "\n".join(lines)
+
+
+def plan_vs_actual(planned: TrainingSession, actual: TrainingSession) -> DiffResult:
+    """Compare a planned session against what was actually logged.
+
+    Movements match by name regardless of order; repeated movements
+    within a session are pooled. Weight deviation is the difference of
+    mean working weights across each side's weighted sets.
+
+    Args:
+        planned: The programmed session (flag '!')
+        actual: The logged session (flag '*') for the same date
+
+    Returns:
+        DiffResult keyed to the actual session's date
+    """
+
+    def pooled(session: TrainingSession) -> dict[str, list[TrainingSet]]:
+        out: dict[str, list[TrainingSet]] = {}
+        for m in session.movements:
+            out.setdefault(m.name, []).extend(m.sets)
+        return out
+
+    def mean_kg(sets: list[TrainingSet]) -> Optional[float]:
+        kgs = [s.weight_kg for s in sets if s.weight_kg is not None]
+        return sum(kgs) / len(kgs) if kgs else None
+
+    planned_by = pooled(planned)
+    actual_by = pooled(actual)
+
+    movements = []
+    for name in sorted(set(planned_by) & set(actual_by)):
+        p_sets, a_sets = planned_by[name], actual_by[name]
+        p_kg, a_kg = mean_kg(p_sets), mean_kg(a_sets)
+        movements.append(
+            MovementDiff(
+                movement=name,
+                planned_sets=len(p_sets),
+                actual_sets=len(a_sets),
+                missed_sets=max(len(p_sets) - len(a_sets), 0),
+                extra_sets=max(len(a_sets) - len(p_sets), 0),
+                weight_delta_kg=round(a_kg - p_kg, 1)
+                if p_kg is not None and a_kg is not None
+                else None,
+            )
+        )
+
+    return DiffResult(
+        date=actual.date,
+        movements=tuple(movements),
+        missed_movements=tuple(sorted(set(planned_by) - set(actual_by))),
+        extra_movements=tuple(sorted(set(actual_by) - set(planned_by))),
+    )
+
+
+ACWR_METRICS = ("tonnage", "sets", "reps")
+
+
+def acwr_zone(value: Optional[float]) -> str:
+    """Classify an ACWR value into a training zone.
+
+    The commonly cited "sweet spot" is 0.8-1.3; ratios above that carry
+    elevated injury risk.
+    """
+    if value is None:
+        return "N/A"
+    if value < 0.8:
+        return "undertraining"
+    if value <= 1.3:
+        return "sweet spot"
+    if value <= 1.5:
+        return "caution"
+    return "danger"
+
+
+def acwr(
+    log: TrainingLog,
+    as_of: date,
+    metric: str = "tonnage",
+    acute_days: int = 7,
+    chronic_days: int = 28,
+) -> Optional[float]:
+    """Acute:Chronic Workload Ratio as of a given date.
+
+    Acute load is the sum over the trailing *acute_days* days and chronic
+    load is the trailing *chronic_days* average expressed at the same
+    weekly rate, so a steady training load gives a ratio of 1.0.
+
+    Args:
+        log: The training log
+        as_of: Date the ratio is computed for (inclusive)
+        metric: Load measure — "tonnage" (kg), "sets", or "reps"
+        acute_days: Acute window length in days
+        chronic_days: Chronic window length in days
+
+    Returns:
+        The ratio rounded to 2 places, or None when the log has less
+        than *chronic_days* days of history before as_of or no chronic
+        load to divide by.
+
+    Raises:
+        ValueError: If metric is not one of ACWR_METRICS.
+    """
+    if metric not in ACWR_METRICS:
+        raise ValueError(
+            f"Unknown ACWR metric: {metric!r}. Expected one of "
+            f"{', '.join(ACWR_METRICS)}"
+        )
+
+    def session_load(session: TrainingSession) -> float:
+        load = 0.0
+        for movement in session.movements:
+            for s in movement.sets:
+                if metric == "sets":
+                    load += 1
+                elif metric == "reps":
+                    load += s.reps
+                elif s.weight_kg is not None:
+                    load += s.reps * s.weight_kg
+        return load
+
+    sessions = [s for s in log.completed_sessions if s.date <= as_of]
+    if not sessions:
+        return None
+    chronic_start = as_of - timedelta(days=chronic_days - 1)
+    if min(s.date for s in sessions) > chronic_start:
+        return None
+
+    acute_start = as_of - timedelta(days=acute_days - 1)
+    acute = sum(session_load(s) for s in sessions if s.date >= acute_start)
+    chronic_total = sum(session_load(s) for s in sessions if s.date >= chronic_start)
+    chronic = chronic_total / (chronic_days / acute_days)
+    if chronic == 0:
+        return None
+    return round(acute / chronic, 2)
+
+
+@dataclass(frozen=True, slots=True)
+class Anomaly:
+    """A set whose numbers look like a data-entry mistake.
+
+    Attributes:
+        date: Session date the set was logged on
+        movement: Movement name
+        training_set: The offending set
+        reason: Human-readable description of what looks wrong
+        expected_max: Upper bound of the plausible range (kg for weight
+            anomalies, reps for rep anomalies)
+        severity: "warning" for a suspicious jump, "error" when the value
+            is almost certainly a typo
+    """
+
+    date: date
+    movement: str
+    training_set: TrainingSet
+    reason: str
+    expected_max: float
+    severity: str
+
+
+def detect_anomalies(
+    log: TrainingLog,
+    max_jump_pct: float = 50.0,
+    max_reps: int = 100,
+    window_sessions: int = 5,
+) -> list[Anomaly]:
+    """Flag sets with implausible weights or reps (likely fat-finger typos).
+
+    A weight anomaly is a set more than *max_jump_pct* percent above the
+    movement's recent max — the best weight across its last
+    *window_sessions* appearances. A jump past double that bound is
+    reported as an "error"; anything over the bound as a "warning".
+    Rep counts above *max_reps* are always errors.
+
+    These are advisory only: the log still loads and the sets still count
+    toward analysis until the source is corrected.
+
+    Returns:
+        Anomalies in chronological order.
+    """
+    anomalies = []
+    history: dict[str, list[float]] = {}
+
+    for session in sorted(log.completed_sessions, key=lambda s: s.date):
+        session_best: dict[str, float] = {}
+        for movement in session.movements:
+            flagged: set[int] = set()
+            for s in movement.sets:
+                if s.reps > max_reps:
+                    anomalies.append(
+                        Anomaly(
+                            date=session.date,
+                            movement=movement.name,
+                            training_set=s,
+                            reason=f"{s.reps} reps looks implausible "
+                            f"(limit {max_reps})",
+                            expected_max=float(max_reps),
+                            severity="error",
+                        )
+                    )
+                if s.weight_kg is None:
+                    continue
+                recent = history.get(movement.name)
+                if not recent:
+                    continue
+                recent_max = max(recent[-window_sessions:])
+                bound = recent_max * (1 + max_jump_pct / 100)
+                if compare_weights(s.weight_kg, bound) > 0:
+                    severity = (
+                        "error"
+                        if compare_weights(s.weight_kg, 2 * bound) > 0
+                        else "warning"
+                    )
+                    anomalies.append(
+                        Anomaly(
+                            date=session.date,
+                            movement=movement.name,
+                            training_set=s,
+                            reason=f"{s.weight_kg:g}kg is more than "
+                            f"{max_jump_pct:g}% above the recent max "
+                            f"{recent_max:g}kg",
+                            expected_max=round(bound, 1),
+                            severity=severity,
+                        )
+                    )
+                    flagged.add(id(s))
+            # Flagged weights stay out of the history so a typo doesn't
+            # legitimize later repeats of itself
+            best = max(
+                (
+                    s.weight_kg
+                    for s in movement.sets
+                    if s.weight_kg is not None and id(s) not in flagged
+                ),
+                default=None,
+            )
+            if best is not None:
+                session_best[movement.name] = max(
+                    session_best.get(movement.name, 0.0), best
+                )
+        for name, best in session_best.items():
+            history.setdefault(name, []).append(best)
+
+    return anomalies
+
+
+@dataclass(frozen=True, slots=True)
+class FatigueWeights:
+    """Weighting for the stimulus-to-fatigue index.
+
+    Each set contributes:
+
+        set_cost + effort * proximity + intensity * relative_intensity
+
+    where proximity is how close the set was to failure (RPE 10 or RIR 0
+    scores 1.0, RPE 5 or RIR 5 scores 0.0) and relative_intensity is the
+    set weight divided by the movement's best e1RM seen so far. Sets
+    without an RPE or RIR drop the effort term and contribute through
+    intensity alone.
+
+    Attributes:
+        set_cost: Flat cost per set performed
+        effort: Weight on proximity to failure
+        intensity: Weight on relative intensity
+    """
+
+    set_cost: float = 1.0
+    effort: float = 1.0
+    intensity: float = 1.0
+
+    def __post_init__(self):
+        if self.set_cost < 0 or self.effort < 0 or self.intensity < 0:
+            raise ValueError("Fatigue weights must be non-negative")
+
+
+def _proximity_to_failure(training_set: TrainingSet) -> Optional[float]:
+    """How close a set was to failure, 0.0 (easy) to 1.0 (maximal).
+
+    Derived from RPE (5 and below maps to 0) or RIR (5 and above maps
+    to 0). None when neither was recorded.
+    """
+    if training_set.rpe is not None:
+        return min(max((training_set.rpe - 5) / 5, 0.0), 1.0)
+    if training_set.rir is not None:
+        return min(max(1 - training_set.rir / 5, 0.0), 1.0)
+    return None
+
+
+def stimulus_fatigue(
+    session: TrainingSession,
+    log: TrainingLog,
+    weights: Optional[FatigueWeights] = None,
+    stats: Optional[LogStats] = None,
+) -> float:
+    """Estimate how taxing a session was as a single fatigue index.
+
+    Sums the per-set contribution described on FatigueWeights across the
+    session. Relative intensity is judged against the movement's best
+    e1RM in the log up to and including the session date, so the same
+    absolute load scores lower as the lifter gets stronger.
+
+    Args:
+        session: The session to score
+        log: The log providing e1RM history for relative intensity
+        weights: Term weighting; defaults to FatigueWeights()
+        stats: Precomputed LogStats to avoid rescanning the log
+
+    Returns:
+        The fatigue index, rounded to 2 places. Higher is more taxing.
+    """
+    if weights is None:
+        weights = FatigueWeights()
+
+    history = session_best_e1rms(log, stats=stats)
+    total = 0.0
+    for movement in session.movements:
+        best = max(
+            (e1rm for d, e1rm in history.get(movement.name, []) if d <= session.date),
+            default=None,
+        )
+        for s in movement.sets:
+            contribution = weights.set_cost
+            proximity = _proximity_to_failure(s)
+            if proximity is not None:
+                contribution += weights.effort * proximity
+            if best and s.weight_kg is not None:
+                contribution += weights.intensity * (s.weight_kg / best)
+            total += contribution
+    return round(total, 2)
+
+
+@dataclass(frozen=True, slots=True)
+class Frequency:
+    """How often a movement (or tag) shows up in training.
+
+    Attributes:
+        name: Movement name, or tag name when grouped by tag
+        sessions: Number of sessions it appeared in within the range
+        per_week: Sessions per week over the range
+        avg_gap_days: Mean days between consecutive appearances, None
+            when it appeared only once (a gap of zero would be a lie)
+    """
+
+    name: str
+    sessions: int
+    per_week: float
+    avg_gap_days: Optional[float]
+
+
+def frequency(
+    log: TrainingLog,
+    start: Optional[date] = None,
+    end: Optional[date] = None,
+    by_tag: bool = False,
+) -> dict[str, Frequency]:
+    """Per-movement (or per-tag) training frequency over a date range.
+
+    The range defaults to the log's first and last completed session.
+    With by_tag=True, appearances are grouped by definition tags instead
+    of movement names — a day with both pullups and rows counts once for
+    a shared "vertical-pull" tag.
+
+    Args:
+        log: The training log
+        start: Range start, inclusive (default: first completed session)
+        end: Range end, inclusive (default: last completed session)
+        by_tag: Group by movement-definition tags instead of names
+
+    Returns:
+        Dict of name to Frequency. Empty when nothing was trained in
+        the range.
+    """
+    sessions = [
+        s
+        for s in log.completed_sessions
+        if (start is None or s.date >= start) and (end is None or s.date <= end)
+    ]
+    if not sessions:
+        return {}
+
+    tags_for = {d.name: d.tags for d in log.movement_definitions}
+    dates_by_name: dict[str, set[date]] = {}
+    for session in sessions:
+        for movement in session.movements:
+            keys = tags_for.get(movement.name, ()) if by_tag else (movement.name,)
+            for key in keys:
+                dates_by_name.setdefault(key, set()).add(session.date)
+
+    range_start = start if start is not None else min(s.date for s in sessions)
+    range_end = end if end is not None else max(s.date for s in sessions)
+    weeks = ((range_end - range_start).days + 1) / 7
+
+    out = {}
+    for name, dates in dates_by_name.items():
+        ordered = sorted(dates)
+        gaps = [(b - a).days for a, b in zip(ordered, ordered[1:])]
+        out[name] = Frequency(
+            name=name,
+            sessions=len(ordered),
+            per_week=round(len(ordered) / weeks, 2),
+            avg_gap_days=round(sum(gaps) / len(gaps), 1) if gaps else None,
+        )
+    return out
+
+
+@dataclass(frozen=True, slots=True)
+class GoalStatus:
+    """Progress against a declared @goal.
+
+    Attributes:
+        movement: Movement name
+        target_kg: Goal 1RM in kilograms
+        best_kg: Best e1RM reached so far, None when never trained
+        gap_kg: Remaining gap (target minus best), 0.0 once achieved
+        achieved: True once the best e1RM met or beat the target
+        achieved_date: Date the target was first reached, None otherwise
+        projected_date: Estimated achievement date at the current e1RM
+            trend, None when achieved, off-track, or with too little
+            history to project
+        on_track: True when achieved or the trend points at the target
+    """
+
+    movement: str
+    target_kg: float
+    best_kg: Optional[float]
+    gap_kg: float
+    achieved: bool
+    achieved_date: Optional[date]
+    projected_date: Optional[date]
+    on_track: bool
+
+
+def goal_progress(
+    log: TrainingLog, stats: Optional[LogStats] = None
+) -> list[GoalStatus]:
+    """Progress report for every @goal declared in the log.
+
+    Judged by estimated 1RM history. An unachieved goal projects its
+    achievement date by extending the e1RM trend regression at its
+    current rate; a flat or negative trend (or fewer than three sessions
+    of history) reports not on track instead of projecting infinity.
+
+    Args:
+        log: Training log
+        stats: Precomputed LogStats; built once internally when omitted
+
+    Returns:
+        GoalStatus per goal, in declaration order.
+    """
+    if stats is None:
+        stats = compute_stats(log)
+    statuses = []
+    for goal in log.goals:
+        target = goal.target_kg
+        history = session_best_e1rms(log, movement=goal.movement, stats=stats).get(
+            goal.movement, []
+        )
+        best = max((e for _, e in history), default=None)
+
+        achieved_date = next((d for d, e in history if e >= target), None)
+        if achieved_date is not None:
+            statuses.append(
+                GoalStatus(
+                    movement=goal.movement,
+                    target_kg=round(target, 1),
+                    best_kg=round(best, 1),
+                    gap_kg=0.0,
+                    achieved=True,
+                    achieved_date=achieved_date,
+                    projected_date=None,
+                    on_track=True,
+                )
+            )
+            continue
+
+        gap = target - best if best is not None else target
+        projected = None
+        on_track = False
+        if len(history) >= 3:
+            slope_per_week, _, points = e1rm_trend(log, goal.movement, stats=stats)
+            if slope_per_week > 0:
+                days = gap / (slope_per_week / 7)
+                projected = points[-1][0] + timedelta(days=round(days))
+                on_track = True
+
+        statuses.append(
+            GoalStatus(
+                movement=goal.movement,
+                target_kg=round(target, 1),
+                best_kg=round(best, 1) if best is not None else None,
+                gap_kg=round(gap, 1),
+                achieved=False,
+                achieved_date=None,
+                projected_date=projected,
+                on_track=on_track,
+            )
+        )
+    return statuses
+
+
+# Mean-velocity zone boundaries (m/s), fastest first. The conventional
+# five-zone VBT chart: a set at or above a bound lands in that zone.
+VELOCITY_ZONES = (
+    (1.3, "starting strength"),
+    (1.0, "speed-strength"),
+    (0.75, "strength-speed"),
+    (0.5, "accelerative strength"),
+    (0.0, "absolute strength"),
+)
+
+
+def velocity_zone(training_set: TrainingSet) -> Optional[str]:
+    """Classify a set's training zone by its logged mean bar velocity.
+
+    Uses the conventional five-zone velocity-based-training chart, from
+    "absolute strength" (under 0.5 m/s) up to "starting strength"
+    (1.3 m/s and faster). Returns None when the set has no velocity
+    recorded, so callers can distinguish "unmeasured" from any zone.
+
+    Args:
+        training_set: A single set
+
+    Returns:
+        Zone name, or None without a recorded velocity
+    """
+    if training_set.velocity is None:
+        return None
+    for bound, zone in VELOCITY_ZONES:
+        if training_set.velocity >= bound:
+            return zone
+    return VELOCITY_ZONES[-1][1]
@@ -0,0 +1,105 @@
+"""ASCII progress chart plugin for ox.
+
+Draws a movement's estimated 1RM, top-set weight, or session volume over
+time as a terminal scatter chart. The date axis is proportional, so gaps
+between sessions show up as gaps.
+
+Usage:
+    chart -m squat
+    chart -m squat --metric weight
+    chart -m deadlift --metric volume -w 80
+"""
+
+from ox.analysis import session_best_e1rms
+from ox.plot import _DEFAULT_WIDTH
+from ox.plugins import PlotResult, PluginContext
+from ox import plot
+
+METRICS = ("e1rm", "weight", "volume")
+
+
+def _session_series(log, movement: str, metric: str) -> list[tuple[str, float]]:
+    """Per-session (iso date, value) pairs for a movement and metric."""
+    if metric == "e1rm":
+        history = session_best_e1rms(log, movement=movement).get(movement, [])
+        return [(d.isoformat(), round(e, 1)) for d, e in history]
+
+    points = []
+    for session in sorted(log.completed_sessions, key=lambda s: s.date):
+        kgs = [
+            (s.weight_kg, s.reps)
+            for m in session.movements
+            if m.name == movement
+            for s in m.sets
+            if s.weight_kg is not None
+        ]
+        if not kgs:
+            continue
+        if metric == "weight":
+            value = max(kg for kg, _ in kgs)
+        else:  # volume
+            value = sum(kg * reps for kg, reps in kgs)
+        points.append((session.date.isoformat(), round(value, 1)))
+    return points
+
+
+def chart(ctx: PluginContext, movement, metric="e1rm", width=_DEFAULT_WIDTH):
+    """Chart a movement's progress over time.
+
+    Args:
+        ctx: Plugin context with db and log
+        movement: Movement name
+        metric: Series to draw ("e1rm", "weight", "volume"), all in kg
+        width: Chart width in characters
+    """
+    if metric not in METRICS:
+        raise ValueError(
+            f"Unknown metric '{metric}'. Choose from: {', '.join(METRICS)}"
+        )
+
+    points = _session_series(ctx.log, movement, metric)
+    if not points:
+        return PlotResult([f"No weighted sets found for '{movement}'."])
+
+    dates = [d for d, _ in points]
+    values = [v for _, v in points]
+    return PlotResult(
+        plot.scatter(
+            dates,
+            values,
+            y_label=f"{metric} (kg)",
+            title=movement,
+            width=width,
+        )
+    )
+
+
+def register():
+    return [
+        {
+            "name": "chart",
+            "fn": chart,
+            "description": "ASCII progress chart for a movement",
+            "params": [
+                {
+                    "name": "movement",
+                    "type": str,
+                    "required": True,
+                    "short": "m",
+                },
+                {
+                    "name": "metric",
+                    "type": str,
+                    "default": "e1rm",
+                    "required": False,
+                },
+                {
+                    "name": "width",
+                    "type": int,
+                    "default": _DEFAULT_WIDTH,
+                    "required": False,
+                    "short": "w",
+                },
+            ],
+        }
+    ]
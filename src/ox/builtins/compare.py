@@ -0,0 +1,103 @@
+"""Period comparison plugin for ox.
+
+Contrasts two date ranges — tonnage, set count, average intensity, and
+per-movement best e1RM — with absolute and percent deltas. The quickest
+way to see whether a training block worked.
+
+Usage:
+    compare -a 2025-01-01:2025-03-31 -b 2025-04-01:2025-06-30
+"""
+
+from datetime import datetime
+
+from ox.analysis import compare as _compare
+from ox.analysis import percent_change
+from ox.plugins import PluginContext, TableResult
+
+
+def _parse_range(value, flag):
+    """Parse a 'YYYY-MM-DD:YYYY-MM-DD' range argument."""
+    try:
+        start_str, end_str = value.split(":", 1)
+        start = datetime.strptime(start_str, "%Y-%m-%d").date()
+        end = datetime.strptime(end_str, "%Y-%m-%d").date()
+    except ValueError:
+        raise ValueError(
+            f"Invalid range for -{flag}: {value!r}. Expected YYYY-MM-DD:YYYY-MM-DD"
+        )
+    return start, end
+
+
+def compare(ctx: PluginContext, a, b):
+    """Compare training between two date ranges.
+
+    Args:
+        ctx: Plugin context with db and log
+        a: First period as start:end (YYYY-MM-DD:YYYY-MM-DD)
+        b: Second period as start:end
+    """
+    a_start, a_end = _parse_range(a, "a")
+    b_start, b_end = _parse_range(b, "b")
+    report = _compare(ctx.log, a_start, a_end, b_start, b_end)
+
+    rows = [
+        (
+            "tonnage (kg)",
+            report.tonnage_a_kg,
+            report.tonnage_b_kg,
+            round(report.tonnage_b_kg - report.tonnage_a_kg, 1),
+            percent_change(report.tonnage_a_kg, report.tonnage_b_kg),
+        ),
+        (
+            "sets",
+            report.sets_a,
+            report.sets_b,
+            report.sets_b - report.sets_a,
+            percent_change(report.sets_a, report.sets_b),
+        ),
+        (
+            "avg intensity (kg/rep)",
+            report.avg_intensity_a_kg,
+            report.avg_intensity_b_kg,
+            round(report.avg_intensity_b_kg - report.avg_intensity_a_kg, 1)
+            if report.avg_intensity_a_kg is not None
+            and report.avg_intensity_b_kg is not None
+            else None,
+            percent_change(report.avg_intensity_a_kg, report.avg_intensity_b_kg),
+        ),
+    ]
+    for m in report.movements:
+        delta = m.delta_kg if m.status == "common" else m.status
+        rows.append(
+            (f"e1rm {m.movement} (kg)", m.best_a_kg, m.best_b_kg, delta, m.delta_pct)
+        )
+
+    return TableResult(
+        columns=["metric", "period a", "period b", "delta", "delta %"],
+        rows=rows,
+        note=f"a: {a_start} to {a_end}, b: {b_start} to {b_end}",
+    )
+
+
+def register():
+    return [
+        {
+            "name": "compare",
+            "fn": compare,
+            "description": "Compare tonnage, sets, intensity, and e1RMs between two periods",
+            "params": [
+                {
+                    "name": "a",
+                    "type": str,
+                    "required": True,
+                    "short": "a",
+                },
+                {
+                    "name": "b",
+                    "type": str,
+                    "required": True,
+                    "short": "b",
+                },
+            ],
+        }
+    ]
@@ -0,0 +1,63 @@
+"""Consistency metrics plugin for ox.
+
+Reports training days, longest and current streaks, and average sessions
+per week. Marked rest days (``2025-01-12 rest``) keep a streak alive.
+
+Usage:
+    consistency
+    consistency -s 2025-01-01 -e 2025-03-31
+"""
+
+from datetime import datetime
+
+from ox.analysis import consistency as _consistency
+from ox.plugins import PluginContext, TableResult
+
+
+def consistency(ctx: PluginContext, start=None, end=None):
+    """Show streak and consistency metrics.
+
+    Args:
+        ctx: Plugin context with db and log
+        start: Range start YYYY-MM-DD (default first session)
+        end: Range end YYYY-MM-DD (default last session); the current
+            streak is measured as of this day
+    """
+    start_date = datetime.strptime(start, "%Y-%m-%d").date() if start else None
+    end_date = datetime.strptime(end, "%Y-%m-%d").date() if end else None
+    result = _consistency(ctx.log, start=start_date, end=end_date)
+    return TableResult(
+        columns=["metric", "value"],
+        rows=[
+            ("training days", result.training_days),
+            ("longest streak", result.longest_streak),
+            ("current streak", result.current_streak),
+            ("avg sessions/week", result.avg_sessions_per_week),
+        ],
+    )
+
+
+def register():
+    return [
+        {
+            "name": "consistency",
+            "fn": consistency,
+            "description": "Show training streaks and session frequency",
+            "params": [
+                {
+                    "name": "start",
+                    "type": str,
+                    "default": None,
+                    "required": False,
+                    "short": "s",
+                },
+                {
+                    "name": "end",
+                    "type": str,
+                    "default": None,
+                    "required": False,
+                    "short": "e",
+                },
+            ],
+        }
+    ]
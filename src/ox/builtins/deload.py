@@ -0,0 +1,73 @@
+"""Deload recommendation plugin for ox.
+
+Turns fatigue signals — rising weekly tonnage, stalled estimated 1RMs,
+and climbing average RPE — into a yes/no deload suggestion with the
+reasoning spelled out.
+
+Usage:
+    deload
+    deload -w 3 --rise 1.2
+"""
+
+from ox.analysis import DeloadOptions, recommend_deload
+from ox.plugins import PluginContext, TextResult
+
+
+def deload(ctx: PluginContext, window=4, rise=1.10, gain=1.0, rpe=0.5):
+    """Suggest whether a deload week is advisable.
+
+    Args:
+        ctx: Plugin context with db and log
+        window: Recent period length in weeks
+        rise: Tonnage ratio vs baseline that counts as rising
+        gain: Minimum e1RM gain (kg) that counts as progress
+        rpe: Average RPE rise that counts as climbing
+    """
+    _, reason = recommend_deload(
+        ctx.log,
+        DeloadOptions(
+            window_weeks=window,
+            tonnage_rise=rise,
+            min_e1rm_gain_kg=gain,
+            rpe_rise=rpe,
+        ),
+    )
+    return TextResult(reason)
+
+
+def register():
+    return [
+        {
+            "name": "deload",
+            "fn": deload,
+            "description": "Recommend a deload week from fatigue metrics",
+            "params": [
+                {
+                    "name": "window",
+                    "type": int,
+                    "default": 4,
+                    "required": False,
+                    "short": "w",
+                },
+                {
+                    "name": "rise",
+                    "type": float,
+                    "default": 1.10,
+                    "required": False,
+                },
+                {
+                    "name": "gain",
+                    "type": float,
+                    "default": 1.0,
+                    "required": False,
+                    "short": "g",
+                },
+                {
+                    "name": "rpe",
+                    "type": float,
+                    "default": 0.5,
+                    "required": False,
+                },
+            ],
+        }
+    ]
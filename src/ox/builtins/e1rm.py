@@ -7,6 +7,7 @@ Only considers sets tagged with "^rm" in the movement note
 Usage:
     e1rm -m deadlift
     e1rm -m squat -f epley
+    e1rm -m squat -f all
     e1rm -m deadlift -o plot
 
 Example .ox line:
@@ -17,6 +18,9 @@ from ox import plot
 from ox.plugins import PlotResult, PluginContext, TableResult
 from ox.units import Q_
 
+# Formulas diverge badly above this rep count; estimates are clamped there.
+MAX_RELIABLE_REPS = 12
+
 
 def _brzycki(weight, reps):
     """Brzycki formula: weight * 36 / (37 - reps)."""
@@ -30,17 +34,63 @@ def _epley(weight, reps):
     return weight * (1 + reps / 30)
 
 
+def _lombardi(weight, reps):
+    """Lombardi formula: weight * reps^0.10."""
+    return weight * reps**0.10
+
+
+def _lander(weight, reps):
+    """Lander formula: 100 * weight / (101.3 - 2.67123 * reps)."""
+    return 100 * weight / (101.3 - 2.67123 * reps)
+
+
 FORMULAS = {
     "brzycki": _brzycki,
     "epley": _epley,
+    "lombardi": _lombardi,
+    "lander": _lander,
 }
 
 
+def one_rep_max(weight: float, reps: int, formula: str = "brzycki") -> float:
+    """Estimate a one-rep max from a single set.
+
+    Reps above MAX_RELIABLE_REPS are clamped before applying the formula,
+    since all common estimators are unreliable at high rep counts.
+
+    Args:
+        weight: Weight lifted (any unit; result is in the same unit)
+        reps: Reps performed (must be >= 1)
+        formula: One of "brzycki", "epley", "lombardi", "lander"
+
+    Raises:
+        ValueError: If weight <= 0, reps < 1, or formula is unknown
+    """
+    if formula not in FORMULAS:
+        raise ValueError(
+            f"Unknown formula '{formula}'. Choose from: {', '.join(FORMULAS)}"
+        )
+    if weight <= 0:
+        raise ValueError(f"weight must be positive, got {weight}")
+    if reps < 1:
+        raise ValueError(f"reps must be >= 1, got {reps}")
+    return FORMULAS[formula](weight, min(reps, MAX_RELIABLE_REPS))
+
+
+def one_rep_max_all(weight: float, reps: int) -> dict[str, float]:
+    """Estimate a one-rep max with every known formula.
+
+    Returns:
+        Dict of formula name to estimated 1RM
+    """
+    return {name: one_rep_max(weight, reps, name) for name in FORMULAS}
+
+
 def estimated_1rm(
     ctx: PluginContext,
     movement,
     formula="brzycki",
-    unit="lb",
+    unit=None,
     output="table",
     width=None,
     height=None,
@@ -51,15 +101,17 @@ def estimated_1rm(
 
     Finds sets where the movement note contains "^rm", takes the
     heaviest set per movement line, and calculates estimated 1RM.
+    Pass formula="all" to compare every known formula side by side.
     """
-    if formula not in FORMULAS:
+    unit = unit or ctx.log.default_unit or "lb"
+    if formula != "all" and formula not in FORMULAS:
         raise ValueError(
-            f"Unknown formula '{formula}'. Choose from: {', '.join(FORMULAS)}"
+            f"Unknown formula '{formula}'. Choose from: {', '.join(FORMULAS)}, all"
         )
     if output not in ("table", "plot"):
         raise ValueError("output must be 'table' or 'plot'")
-
-    calc = FORMULAS[formula]
+    if formula == "all" and output == "plot":
+        raise ValueError("formula 'all' only supports table output")
 
     rows = ctx.db.execute(
         """
@@ -73,17 +125,22 @@ def estimated_1rm(
           AND t.flag IS '*'
           AND t.movement_note LIKE '%^rm%'
           AND t.weight_magnitude IS NOT NULL
+          AND t.reps >= 1
         ORDER BY t.date, t.weight_magnitude DESC
         """,
         (movement,),
     ).fetchall()
 
+    if formula == "all":
+        columns = ["date"] + [f"{name} ({unit})" for name in FORMULAS]
+        columns += [f"weight ({unit})", "reps"]
+    else:
+        columns = ["date", f"estimated_1rm ({unit})", f"weight ({unit})", "reps"]
+
     if not rows:
         if output == "plot":
             return PlotResult([])
-        return TableResult(
-            ["date", f"estimated_1rm ({unit})", f"weight ({unit})", "reps"], []
-        )
+        return TableResult(columns, [])
 
     seen_dates = {}
     for date, raw_weight, reps, raw_unit in rows:
@@ -94,8 +151,14 @@ def estimated_1rm(
     for date in sorted(seen_dates):
         raw_weight, reps, raw_unit = seen_dates[date]
         converted = round(float(Q_(raw_weight, raw_unit).to(unit).magnitude), 1)
-        e1rm = round(calc(converted, reps), 1)
-        result.append((date, e1rm, converted, reps))
+        if formula == "all":
+            estimates = one_rep_max_all(converted, reps)
+            result.append(
+                (date, *(round(v, 1) for v in estimates.values()), converted, reps)
+            )
+        else:
+            e1rm = round(one_rep_max(converted, reps, formula), 1)
+            result.append((date, e1rm, converted, reps))
 
     if output == "plot":
         dates = [row[0] for row in result]
@@ -113,7 +176,6 @@ def estimated_1rm(
             kwargs["x_scale"] = x_scale
         return PlotResult(plot.scatter(dates, values, **kwargs))
 
-    columns = ["date", f"estimated_1rm ({unit})", f"weight ({unit})", "reps"]
     return TableResult(columns, result)
 
 
@@ -135,7 +197,7 @@ def register():
                 {
                     "name": "unit",
                     "type": str,
-                    "default": "lb",
+                    "default": None,
                     "required": False,
                     "short": "u",
                 },
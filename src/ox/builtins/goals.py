@@ -0,0 +1,55 @@
+"""Goal progress plugin for ox.
+
+Reports each @goal declared in the log against e1RM history: current
+best, remaining gap, and a projected achievement date from the e1RM
+trend. Flat or negative trends show "not on track" instead of a date.
+
+Usage:
+    goals
+"""
+
+from ox.analysis import goal_progress
+from ox.plugins import PluginContext, TableResult
+
+
+def goals(ctx: PluginContext):
+    """Progress against declared @goal directives.
+
+    Args:
+        ctx: Plugin context with db and log
+    """
+    rows = []
+    for status in goal_progress(ctx.log):
+        if status.achieved:
+            projection = f"achieved {status.achieved_date.isoformat()}"
+        elif status.projected_date is not None:
+            projection = status.projected_date.isoformat()
+        else:
+            projection = "not on track"
+        rows.append(
+            (
+                status.movement,
+                status.target_kg,
+                status.best_kg,
+                status.gap_kg,
+                projection,
+            )
+        )
+
+    note = None if rows else "No @goal directives declared in the log"
+    return TableResult(
+        columns=["movement", "target (kg)", "best e1rm (kg)", "gap (kg)", "projection"],
+        rows=rows,
+        note=note,
+    )
+
+
+def register():
+    return [
+        {
+            "name": "goals",
+            "fn": goals,
+            "description": "Progress and projected achievement dates for @goal targets",
+            "params": [],
+        }
+    ]
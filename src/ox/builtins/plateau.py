@@ -0,0 +1,83 @@
+"""Plateau detection plugin for ox.
+
+Flags movements whose best estimated 1RM hasn't improved over a trailing
+window, so stalls get noticed before they turn into months.
+
+Usage:
+    plateau
+    plateau -w 42 -g 2.5
+    plateau -s 4
+"""
+
+from ox.analysis import detect_plateaus
+from ox.plugins import PluginContext, TableResult
+
+
+def plateau(ctx: PluginContext, window=28, gain=1.0, sessions=3):
+    """List movements whose estimated 1RM has stalled.
+
+    Args:
+        ctx: Plugin context with db and log
+        window: Trailing window length in days
+        gain: Minimum e1RM gain (kg) that counts as progress
+        sessions: Minimum sessions in the window to consider a movement
+    """
+    plateaus = detect_plateaus(
+        ctx.log,
+        window_days=window,
+        min_gain_kg=gain,
+        min_sessions=sessions,
+    )
+    rows = [
+        (
+            p.movement,
+            p.window_start.isoformat(),
+            p.window_end.isoformat(),
+            p.last_improvement.isoformat() if p.last_improvement else "",
+            p.best_e1rm_kg,
+            p.sessions_in_window,
+        )
+        for p in plateaus
+    ]
+    columns = [
+        "movement",
+        "stale_from",
+        "stale_to",
+        "last_improvement",
+        "best_e1rm (kg)",
+        "sessions",
+    ]
+    return TableResult(columns, rows)
+
+
+def register():
+    return [
+        {
+            "name": "plateau",
+            "fn": plateau,
+            "description": "Detect movements whose estimated 1RM has stalled",
+            "params": [
+                {
+                    "name": "window",
+                    "type": int,
+                    "default": 28,
+                    "required": False,
+                    "short": "w",
+                },
+                {
+                    "name": "gain",
+                    "type": float,
+                    "default": 1.0,
+                    "required": False,
+                    "short": "g",
+                },
+                {
+                    "name": "sessions",
+                    "type": int,
+                    "default": 3,
+                    "required": False,
+                    "short": "s",
+                },
+            ],
+        }
+    ]
@@ -0,0 +1,137 @@
+"""Plate-loading calculator plugin for ox.
+
+Works out which plates to put on each side of the bar to hit a target
+weight, and how far short the closest achievable loadout is when the
+target can't be hit exactly.
+
+Usage:
+    plates -t 142.5
+    plates -t 315 -b 45 -a 45,25,10,5,2.5 -u lb
+"""
+
+from ox.plugins import PluginContext, TextResult
+
+# Standard kg plate denominations, one side of the bar.
+STANDARD_PLATES = (25.0, 20.0, 15.0, 10.0, 5.0, 2.5, 1.25)
+
+DEFAULT_BAR_KG = 20.0
+
+
+def load(
+    target: float,
+    bar: float = DEFAULT_BAR_KG,
+    available: tuple[float, ...] | None = None,
+) -> tuple[list[float], float]:
+    """Compute the plates for one side of the bar to reach a target weight.
+
+    Finds the closest achievable total at or below the target (plates are
+    assumed unlimited), so an unreachable target reports how far short the
+    loadout is rather than failing.
+
+    Args:
+        target: Total weight to load, bar included
+        bar: Bar weight (default 20kg)
+        available: Plate denominations on hand (default standard kg plates)
+
+    Returns:
+        Tuple of (per-side plates, heaviest first; residual weight the
+        loadout falls short of the target)
+
+    Raises:
+        ValueError: If the target is below the bar weight
+    """
+    if available is None:
+        available = STANDARD_PLATES
+    if target < bar:
+        raise ValueError(f"Target {target:g} is below the bar weight {bar:g}")
+
+    # Work in integer hundredths to sidestep float accumulation.
+    per_side = int(round((target - bar) / 2 * 100))
+    coins = sorted({int(round(p * 100)) for p in available if p > 0}, reverse=True)
+
+    reachable = [False] * (per_side + 1)
+    parent = [0] * (per_side + 1)
+    reachable[0] = True
+    for s in range(1, per_side + 1):
+        for c in coins:
+            if c <= s and reachable[s - c]:
+                reachable[s] = True
+                parent[s] = c
+                break
+
+    best = max(s for s in range(per_side + 1) if reachable[s])
+    plates = []
+    while best > 0:
+        plates.append(parent[best] / 100)
+        best -= parent[best]
+
+    achieved = bar + 2 * sum(plates)
+    return sorted(plates, reverse=True), round(target - achieved, 2)
+
+
+def plates(ctx: PluginContext, target, bar=DEFAULT_BAR_KG, available=None, unit="kg"):
+    """Show the plate loadout for a target weight.
+
+    Args:
+        ctx: Plugin context with db and log
+        target: Total weight to load, bar included
+        bar: Bar weight
+        available: Comma-separated plate denominations (default standard kg)
+        unit: Unit label for output (display only; all inputs share it)
+    """
+    denominations = None
+    if available:
+        denominations = tuple(float(p) for p in available.split(","))
+
+    per_side, residual = load(target, bar=bar, available=denominations)
+
+    if per_side:
+        side = " + ".join(f"{p:g}" for p in per_side)
+    else:
+        side = "empty bar"
+    lines = [f"Per side: {side} {unit}" if per_side else f"Per side: {side}"]
+    achieved = target - residual
+    if residual > 0:
+        lines.append(f"Total: {achieved:g}{unit} ({residual:g}{unit} short)")
+    else:
+        lines.append(f"Total: {achieved:g}{unit}")
+    return TextResult("\n".join(lines) + "\n")
+
+
+def register():
+    return [
+        {
+            "name": "plates",
+            "fn": plates,
+            "description": "Plate-loading calculator for a target bar weight",
+            "params": [
+                {
+                    "name": "target",
+                    "type": float,
+                    "required": True,
+                    "short": "t",
+                },
+                {
+                    "name": "bar",
+                    "type": float,
+                    "default": DEFAULT_BAR_KG,
+                    "required": False,
+                    "short": "b",
+                },
+                {
+                    "name": "available",
+                    "type": str,
+                    "default": None,
+                    "required": False,
+                    "short": "a",
+                },
+                {
+                    "name": "unit",
+                    "type": str,
+                    "default": "kg",
+                    "required": False,
+                    "short": "u",
+                },
+            ],
+        }
+    ]
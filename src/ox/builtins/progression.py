@@ -0,0 +1,189 @@
+"""Progressive overload session writer plugin for ox.
+
+Generates the next planned session(s) for a movement by adding a fixed
+increment per week, optionally with a deload week. Outputs valid .ox text
+with planned (!) flag, ready to paste into a log.
+
+Usage:
+    progression -m squat -w 100
+    progression -m squat -w 100 -i 2.5 -s 3 -r 5
+    progression -m squat --wave 3+1
+    progression -m bench-press -u lb -p 5
+"""
+
+from datetime import datetime, timedelta
+
+from ox.data import Movement, TrainingSession, TrainingSet
+from ox.plugins import PluginContext, TextResult
+from ox.rounding import Rounding
+from ox.units import Q_
+
+# Deload weeks use this fraction of the last work week's weight.
+DELOAD_FRACTION = 0.6
+
+
+def _pint_unit(unit):
+    """Map short unit strings to pint-compatible unit strings."""
+    return {"lb": "pound", "lbs": "pound", "kg": "kilogram"}.get(unit, unit)
+
+
+def _parse_wave(wave):
+    """Parse a wave spec like "3" or "3+1" into (work_weeks, deload_weeks)."""
+    if "+" in wave:
+        work, deload = wave.split("+", 1)
+        return int(work), int(deload)
+    return int(wave), 0
+
+
+def progression(
+    ctx: PluginContext,
+    movement,
+    weight=None,
+    increment=2.5,
+    sets=5,
+    reps=5,
+    wave="1",
+    unit="kg",
+    plate=2.5,
+    mode="down",
+    start_date=None,
+):
+    """Generate planned session(s) progressing a movement's working weight.
+
+    Args:
+        ctx: Plugin context with db and log
+        movement: Movement name
+        weight: Current working weight (defaults to the movement's most
+            recent top set from the log)
+        increment: Weight added per work week
+        sets: Sets per session
+        reps: Reps per set
+        wave: Periodization spec: work weeks, optionally "+deload" weeks
+            (e.g. "3+1" is three weeks up then one deload at 60%)
+        unit: Weight unit for output
+        plate: Plate increment to round weights to
+        mode: Rounding direction ("nearest", "down", or "up"); down by
+            default so every session is actually loadable
+        start_date: Date of the first session (defaults to today)
+    """
+    pint_unit = _pint_unit(unit)
+    rounding = Rounding(increment=plate, mode=mode)
+
+    if weight is None:
+        history = ctx.log.movement_history(movement)
+        if not history:
+            raise ValueError(
+                f"No logged history for '{movement}'; pass --weight explicitly"
+            )
+        top = history[-1][1].top_set_weight
+        if top is None:
+            raise ValueError(
+                f"'{movement}' has no weighted sets; pass --weight explicitly"
+            )
+        weight = top.to(pint_unit).magnitude
+
+    if start_date:
+        date = datetime.strptime(start_date, "%Y-%m-%d").date()
+    else:
+        date = datetime.now().date()
+
+    work_weeks, deload_weeks = _parse_wave(wave)
+    weekly_weights = [weight + increment * (w + 1) for w in range(work_weeks)]
+    weekly_weights += [weekly_weights[-1] * DELOAD_FRACTION] * deload_weeks
+
+    sessions = []
+    for week, raw in enumerate(weekly_weights, start=1):
+        rounded = rounding.round(raw)
+        session_sets = [
+            TrainingSet(reps=reps, weight=Q_(rounded, pint_unit)) for _ in range(sets)
+        ]
+        note = "deload" if week > work_weeks else None
+        sessions.append(
+            TrainingSession(
+                date=date + timedelta(weeks=week - 1),
+                flag="!",
+                name=f"{movement}-week-{week}",
+                movements=(Movement(name=movement, sets=session_sets, note=note),),
+            )
+        )
+
+    return TextResult("\n\n".join(s.to_ox() for s in sessions) + "\n")
+
+
+def register():
+    return [
+        {
+            "name": "progression",
+            "fn": progression,
+            "description": "Generate planned sessions with progressive overload",
+            "params": [
+                {
+                    "name": "movement",
+                    "type": str,
+                    "required": True,
+                    "short": "m",
+                },
+                {
+                    "name": "weight",
+                    "type": float,
+                    "default": None,
+                    "required": False,
+                    "short": "w",
+                },
+                {
+                    "name": "increment",
+                    "type": float,
+                    "default": 2.5,
+                    "required": False,
+                    "short": "i",
+                },
+                {
+                    "name": "sets",
+                    "type": int,
+                    "default": 5,
+                    "required": False,
+                    "short": "s",
+                },
+                {
+                    "name": "reps",
+                    "type": int,
+                    "default": 5,
+                    "required": False,
+                    "short": "r",
+                },
+                {
+                    "name": "wave",
+                    "type": str,
+                    "default": "1",
+                    "required": False,
+                },
+                {
+                    "name": "unit",
+                    "type": str,
+                    "default": "kg",
+                    "required": False,
+                    "short": "u",
+                },
+                {
+                    "name": "plate",
+                    "type": float,
+                    "default": 2.5,
+                    "required": False,
+                    "short": "p",
+                },
+                {
+                    "name": "mode",
+                    "type": str,
+                    "default": "down",
+                    "required": False,
+                },
+                {
+                    "name": "start_date",
+                    "type": str,
+                    "default": None,
+                    "required": False,
+                    "short": "d",
+                },
+            ],
+        }
+    ]
@@ -22,6 +22,7 @@ from collections import defaultdict
 from datetime import date as _date, timedelta as _timedelta
 
 from ox import plot
+from ox.analysis import acwr_zone as _acwr_zone
 from ox.plugins import PlotResult, PluginContext, TableResult
 
 _SRPE_PATTERN = re.compile(
@@ -200,19 +201,6 @@ def _acwr_report(
     return TableResult(["date", "acute_AU", "chronic_AU", "ACWR", "zone"], rows)
 
 
-def _acwr_zone(acwr: float | None) -> str:
-    """Classify ACWR into a training zone."""
-    if acwr is None:
-        return "N/A"
-    if acwr < 0.8:
-        return "undertraining"
-    if acwr <= 1.3:
-        return "sweet spot"
-    if acwr <= 1.5:
-        return "caution"
-    return "danger"
-
-
 def _monotony_report(
     data: list[tuple[str, float, float, float]],
 ) -> TableResult:
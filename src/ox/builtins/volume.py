@@ -3,42 +3,189 @@
 Usage:
     volume -m squat
     volume -m squat -b monthly -u kg
+    volume -g total
+    volume -g tag -b weekly
 """
 
+from datetime import date as _date, timedelta as _timedelta
+
 from ox.plugins import PluginContext, TableResult
 from ox.sql_utils import _time_bin_expr, _weight_sql_expr
 
+GROUPS = ("total", "movement", "tag")
+
+
+def _period_range(first: str, last: str, bin: str) -> list[str]:
+    """Enumerate all period keys between two period strings, inclusive."""
+    periods = []
+    if bin == "monthly":
+        y, m = (int(p) for p in first.split("-"))
+        last_y, last_m = (int(p) for p in last.split("-"))
+        while (y, m) <= (last_y, last_m):
+            periods.append(f"{y:04d}-{m:02d}")
+            m += 1
+            if m > 12:
+                y, m = y + 1, 1
+    else:
+        step = _timedelta(days=7 if bin == "weekly" else 1)
+        d = _date.fromisoformat(first)
+        end = _date.fromisoformat(last)
+        while d <= end:
+            periods.append(d.isoformat())
+            d += step
+    return periods
 
-def volume(ctx: PluginContext, movement: str, bin: str = "weekly", unit: str = "lb"):
-    """Volume over time for a single movement.
+
+def _fill_gaps(rows: list[tuple], bin: str, zero_row: tuple) -> list[tuple]:
+    """Add zero rows for periods with no training between first and last.
+
+    Only regular bins (daily/weekly/monthly) can be enumerated; weekly-num
+    rows pass through unchanged.
+    """
+    if not rows or bin == "weekly-num":
+        return rows
+    present = {r[0] for r in rows}
+    filled = list(rows)
+    for period in _period_range(rows[0][0], rows[-1][0], bin):
+        if period not in present:
+            filled.append((period, *zero_row))
+    filled.sort(key=lambda r: r[0])
+    return filled
+
+
+def _bodyweight_note(ctx, where: str, params: tuple) -> str | None:
+    """Note when weightless sets are excluded from tonnage.
+
+    Bodyweight sets only carry a weight when the file declares
+    @bodyweight; without one they count toward reps but not volume.
+    """
+    (excluded,) = ctx.db.execute(
+        f"SELECT COUNT(*) FROM training WHERE {where} AND weight_magnitude IS NULL",
+        params,
+    ).fetchone()
+    if not excluded:
+        return None
+    return (
+        f"{excluded} bodyweight set(s) counted by reps only; "
+        "declare @bodyweight to include them in tonnage"
+    )
+
+
+def volume(
+    ctx: PluginContext,
+    movement: str | None = None,
+    bin: str = "weekly",
+    unit: str | None = None,
+    group: str | None = None,
+    fill: str | None = None,
+    warmups: str | None = None,
+):
+    """Volume over time, for one movement or summarized across the log.
 
     Args:
         ctx: Plugin context with db and log
-        movement: Movement name to filter by
+        movement: Movement name to filter by (omit for a log-wide summary)
         bin: Time bin size ("daily", "weekly", "monthly")
-        unit: Weight unit for output values (default "lb")
+        unit: Weight unit for output values (defaults to the log's
+            @units declaration, or "lb")
+        group: Summary grouping when no movement is given
+            ("total", "movement", "tag"); tags resolve through
+            @movement definitions
+        fill: "true"/"false" — include zero rows for periods with no
+            training (default on for summaries, off for single movements)
+        warmups: "true" to count sets marked warmup (excluded by default)
     """
+    unit = unit or ctx.log.default_unit or "lb"
+    if group is not None and group not in GROUPS:
+        raise ValueError(f"Unknown group '{group}'. Choose from: {', '.join(GROUPS)}")
+    if movement is None and group is None:
+        group = "total"
+
     expr = _time_bin_expr(bin, "date")
     w = _weight_sql_expr("weight_magnitude", "weight_unit", unit)
+    warmup_filter = "1=1" if warmups == "true" else "warmup = 0"
+
+    if movement is not None:
+        rows = ctx.db.execute(
+            f"""
+            SELECT
+                {expr} AS period,
+                ROUND(SUM(reps * {w}), 1)                      AS total_volume,
+                SUM(reps)                                       AS total_reps,
+                ROUND(SUM(reps * {w}) * 1.0 / SUM(reps), 1)   AS avg_weight_per_rep
+            FROM training
+            WHERE movement_name = ? AND {warmup_filter}
+            GROUP BY period
+            ORDER BY period
+            """,
+            (movement,),
+        ).fetchall()
+        if fill == "true":
+            rows = _fill_gaps(rows, bin, (0.0, 0, None))
+        columns = [
+            "period",
+            f"total_volume ({unit})",
+            "total_reps",
+            f"avg_weight_per_rep ({unit})",
+        ]
+        return TableResult(
+            columns,
+            rows,
+            note=_bodyweight_note(
+                ctx, f"movement_name = ? AND {warmup_filter}", (movement,)
+            ),
+        )
+
+    if group == "total":
+        rows = ctx.db.execute(
+            f"""
+            SELECT
+                {expr} AS period,
+                ROUND(SUM(reps * {w}), 1) AS total_volume,
+                SUM(reps)                  AS total_reps,
+                COUNT(*)                   AS total_sets
+            FROM training
+            WHERE {warmup_filter}
+            GROUP BY period
+            ORDER BY period
+            """
+        ).fetchall()
+        if fill != "false":
+            rows = _fill_gaps(rows, bin, (0.0, 0, 0))
+        columns = ["period", f"total_volume ({unit})", "total_reps", "total_sets"]
+        return TableResult(columns, rows, note=_bodyweight_note(ctx, warmup_filter, ()))
+
+    if group == "movement":
+        group_expr = "movement_name"
+        from_clause = "training"
+    else:  # tag
+        group_expr = "mt.tag"
+        from_clause = (
+            "training t "
+            "JOIN movement_definitions md ON md.name = t.movement_name "
+            "JOIN movement_tags mt ON mt.movement_definition_id = md.id"
+        )
+
     rows = ctx.db.execute(
         f"""
         SELECT
             {expr} AS period,
-            ROUND(SUM(reps * {w}), 1)                      AS total_volume,
-            SUM(reps)                                       AS total_reps,
-            ROUND(SUM(reps * {w}) * 1.0 / SUM(reps), 1)   AS avg_weight_per_rep
-        FROM training
-        WHERE movement_name = ?
-        GROUP BY period
-        ORDER BY period
-        """,
-        (movement,),
+            {group_expr} AS grp,
+            ROUND(SUM(reps * {w}), 1) AS total_volume,
+            SUM(reps)                  AS total_reps,
+            COUNT(*)                   AS total_sets
+        FROM {from_clause}
+        WHERE {warmup_filter}
+        GROUP BY period, grp
+        ORDER BY period, grp
+        """
     ).fetchall()
     columns = [
         "period",
+        group,
         f"total_volume ({unit})",
         "total_reps",
-        f"avg_weight_per_rep ({unit})",
+        "total_sets",
     ]
     return TableResult(columns, rows)
 
@@ -48,9 +195,15 @@ def register():
         {
             "name": "volume",
             "fn": volume,
-            "description": "Volume over time for a movement",
+            "description": "Volume over time for a movement or the whole log",
             "params": [
-                {"name": "movement", "type": str, "required": True, "short": "m"},
+                {
+                    "name": "movement",
+                    "type": str,
+                    "default": None,
+                    "required": False,
+                    "short": "m",
+                },
                 {
                     "name": "bin",
                     "type": str,
@@ -61,10 +214,31 @@ def register():
                 {
                     "name": "unit",
                     "type": str,
-                    "default": "lb",
+                    "default": None,
                     "required": False,
                     "short": "u",
                 },
+                {
+                    "name": "group",
+                    "type": str,
+                    "default": None,
+                    "required": False,
+                    "short": "g",
+                },
+                {
+                    "name": "fill",
+                    "type": str,
+                    "default": None,
+                    "required": False,
+                    "short": "f",
+                },
+                {
+                    "name": "warmups",
+                    "type": str,
+                    "default": None,
+                    "required": False,
+                    "short": "w",
+                },
             ],
         }
     ]
@@ -14,6 +14,7 @@ from datetime import datetime, timedelta
 
 from ox.data import Movement, TrainingSession, TrainingSet
 from ox.plugins import PluginContext, TextResult
+from ox.rounding import Rounding
 from ox.units import Q_
 
 # Percentages of training max for each week.
@@ -29,7 +30,7 @@ WEEK_SCHEMES = {
 def _round_weight(weight, unit):
     """Round weight to nearest 5 lbs or 2.5 kg."""
     increment = 2.5 if unit == "kg" else 5
-    return round(weight / increment) * increment
+    return Rounding(increment=increment, mode="nearest").round(weight)
 
 
 def _parse_movements(movements_str):
@@ -1,6 +1,7 @@
 """Command-line interface for ox."""
 
 import sqlite3
+from datetime import datetime
 from importlib.metadata import version as _pkg_version
 
 import click
@@ -13,18 +14,28 @@ from rich.table import Table
 from prompt_toolkit import PromptSession
 from prompt_toolkit.completion import WordCompleter
 
-from ox.parse import process_include_directive, process_plugin_directive, process_node
+from ox.parse import (
+    process_bodyweight_directive,
+    process_goal_directive,
+    process_include_directive,
+    process_plugin_directive,
+    process_units_directive,
+    process_node,
+)
 from ox.data import (
     Diagnostic,
     MovementDefinition,
     Note,
+    RestDay,
     StoredQuery,
     TrainingLog,
     TrainingSession,
     WeighIn,
 )
 from ox.db import create_db
-from ox.lint import collect_diagnostics
+from ox.analysis import plan_vs_actual
+from ox.format import format_source
+from ox.lint import collect_diagnostics, collect_semantic_diagnostics
 from ox.plugins import (
     PLUGINS,
     USER_PLUGINS,
@@ -43,11 +54,13 @@ DEFAULT_TABLE_BOX = box.SIMPLE
 
 def _parse_single_file(
     file_path: Path, parser: Parser
-) -> tuple[list, list, list, list, list, list[str], list[str], list]:
+) -> tuple[
+    list, list, list, list, list, list[str], list[str], list, str | None, object
+]:
     """Parse a single .ox file without resolving includes.
 
     Returns:
-        Tuple of (sessions, notes, queries, weigh_ins, diagnostics, include_paths, plugin_paths, movement_definitions)
+        Tuple of (sessions, notes, queries, weigh_ins, rest_days, diagnostics, include_paths, plugin_paths, movement_definitions, goals, default_unit, bodyweight)
     """
     with open(file_path, "r") as f:
         data = bytes(f.read(), encoding="utf-8")
@@ -55,13 +68,24 @@ def _parse_single_file(
     tree = parser.parse(data)
     root_node = tree.root_node
 
+    # The @units and @bodyweight directives apply file-wide, regardless of position
+    default_unit = None
+    bodyweight = None
+    for child in root_node.children:
+        if child.type == "units_directive" and default_unit is None:
+            default_unit = process_units_directive(child)
+        elif child.type == "bodyweight_directive" and bodyweight is None:
+            bodyweight = process_bodyweight_directive(child)
+
     entries = []
     log_notes = []
     log_queries = []
     log_weigh_ins = []
+    log_rest_days = []
     include_paths = []
     plugin_paths = []
     movement_definitions = []
+    goals = []
     for child in root_node.children:
         if child.type == "include_directive":
             include_paths.append(process_include_directive(child))
@@ -69,7 +93,10 @@ def _parse_single_file(
         if child.type == "plugin_directive":
             plugin_paths.append(process_plugin_directive(child))
             continue
-        result = process_node(child)
+        if child.type == "goal_directive":
+            goals.append(process_goal_directive(child))
+            continue
+        result = process_node(child, default_unit, bodyweight)
         if isinstance(result, TrainingSession):
             entries.append(result)
         elif isinstance(result, Note):
@@ -78,19 +105,27 @@ def _parse_single_file(
             log_queries.append(result)
         elif isinstance(result, WeighIn):
             log_weigh_ins.append(result)
+        elif isinstance(result, RestDay):
+            log_rest_days.append(result)
         elif isinstance(result, MovementDefinition):
             movement_definitions.append(result)
 
-    diagnostics = list(collect_diagnostics(tree))
+    diagnostics = list(collect_diagnostics(tree)) + list(
+        collect_semantic_diagnostics(tree)
+    )
     return (
         entries,
         log_notes,
         log_queries,
         log_weigh_ins,
+        log_rest_days,
         diagnostics,
         include_paths,
         plugin_paths,
         movement_definitions,
+        goals,
+        default_unit,
+        bodyweight,
     )
 
 
@@ -98,11 +133,14 @@ def _load_recursive(
     file_path: Path,
     parser: Parser,
     visited: set[Path],
-) -> tuple[list, list, list, list, list, list, list]:
+) -> tuple[list, list, list, list, list, list, list, list, str | None, object]:
     """Recursively load a file and its includes with cycle detection.
 
+    Each file's @units and @bodyweight directives apply only within that
+    file; the root file's values are the ones reported for the log.
+
     Returns:
-        Tuple of (sessions, notes, queries, weigh_ins, diagnostics, plugin_paths, movement_definitions)
+        Tuple of (sessions, notes, queries, weigh_ins, rest_days, diagnostics, plugin_paths, movement_definitions, goals, default_unit, bodyweight)
     """
     abs_path = file_path.resolve()
 
@@ -115,7 +153,7 @@ def _load_recursive(
             message=f"Circular include detected: {file_path}",
             severity="warning",
         )
-        return [], [], [], [], [diag], [], []
+        return [], [], [], [], [], [diag], [], [], [], None, None
 
     visited.add(abs_path)
 
@@ -128,17 +166,21 @@ def _load_recursive(
             message=f"Included file not found: {file_path}",
             severity="warning",
         )
-        return [], [], [], [], [diag], [], []
+        return [], [], [], [], [], [diag], [], [], [], None, None
 
     (
         entries,
         notes,
         queries,
         weigh_ins,
+        rest_days,
         diagnostics,
         include_paths,
         plugin_paths,
         movement_definitions,
+        goals,
+        default_unit,
+        bodyweight,
     ) = _parse_single_file(abs_path, parser)
 
     for inc_path in include_paths:
@@ -148,26 +190,36 @@ def _load_recursive(
             inc_notes,
             inc_queries,
             inc_weigh_ins,
+            inc_rest_days,
             inc_diagnostics,
             inc_plugins,
             inc_defs,
+            inc_goals,
+            _inc_unit,
+            _inc_bodyweight,
         ) = _load_recursive(Path(resolved), parser, visited)
         entries.extend(inc_entries)
         notes.extend(inc_notes)
         queries.extend(inc_queries)
         weigh_ins.extend(inc_weigh_ins)
+        rest_days.extend(inc_rest_days)
         diagnostics.extend(inc_diagnostics)
         plugin_paths.extend(inc_plugins)
         movement_definitions.extend(inc_defs)
+        goals.extend(inc_goals)
 
     return (
         entries,
         notes,
         queries,
         weigh_ins,
+        rest_days,
         diagnostics,
         plugin_paths,
         movement_definitions,
+        goals,
+        default_unit,
+        bodyweight,
     )
 
 
@@ -190,9 +242,13 @@ def parse_file(file_path: Path) -> TrainingLog:
         notes,
         queries,
         weigh_ins,
+        rest_days,
         diagnostics,
         plugin_paths,
         movement_definitions,
+        goals,
+        default_unit,
+        bodyweight,
     ) = _load_recursive(file_path, parser, visited=set())
 
     return TrainingLog(
@@ -203,6 +259,98 @@ def parse_file(file_path: Path) -> TrainingLog:
         tuple(weigh_ins),
         tuple(plugin_paths),
         tuple(movement_definitions),
+        default_unit,
+        bodyweight,
+        rest_days=tuple(rest_days),
+        goals=tuple(goals),
+    )
+
+
+def parse_dir(dir_path: Path) -> TrainingLog:
+    """Parse every .ox file in a directory into one chronological log.
+
+    Files are loaded in name order (so month-per-file layouts merge
+    naturally) and identical sessions appearing in more than one file are
+    kept once. Each file's @units and @bodyweight directives apply only
+    within that file, so the merged log carries neither. Diagnostics are
+    prefixed with the file they came from.
+
+    Args:
+        dir_path: Directory containing .ox files
+
+    Returns:
+        TrainingLog with sessions sorted by date
+
+    Raises:
+        FileNotFoundError: If the directory does not exist
+        ValueError: If the directory contains no .ox files
+    """
+    from dataclasses import replace
+
+    dir_path = Path(dir_path)
+    if not dir_path.is_dir():
+        raise FileNotFoundError(f"Not a directory: {dir_path}")
+    files = sorted(dir_path.glob("*.ox"))
+    if not files:
+        raise ValueError(f"No .ox files in {dir_path}")
+
+    language = Language(tree_sitter_ox.language())
+    parser = Parser(language)
+
+    sessions: list[TrainingSession] = []
+    seen_sessions: set[str] = set()
+    notes, queries, weigh_ins, rest_days, diagnostics = [], [], [], [], []
+    plugin_paths, movement_definitions, goals = [], [], []
+    for f in files:
+        (
+            file_sessions,
+            file_notes,
+            file_queries,
+            file_weigh_ins,
+            file_rest_days,
+            file_diagnostics,
+            file_plugins,
+            file_defs,
+            file_goals,
+            _unit,
+            _bodyweight,
+        ) = _load_recursive(f, parser, visited=set())
+        for session in file_sessions:
+            key = session.to_ox()
+            if key in seen_sessions:
+                continue
+            seen_sessions.add(key)
+            sessions.append(session)
+        notes.extend(file_notes)
+        queries.extend(file_queries)
+        weigh_ins.extend(file_weigh_ins)
+        for rest_day in file_rest_days:
+            if rest_day not in rest_days:
+                rest_days.append(rest_day)
+        diagnostics.extend(
+            replace(d, message=f"{f.name}: {d.message}") for d in file_diagnostics
+        )
+        plugin_paths.extend(file_plugins)
+        for definition in file_defs:
+            if definition not in movement_definitions:
+                movement_definitions.append(definition)
+        for goal in file_goals:
+            if goal not in goals:
+                goals.append(goal)
+
+    sessions.sort(key=lambda s: s.date)
+    return TrainingLog(
+        tuple(sessions),
+        tuple(notes),
+        tuple(diagnostics),
+        tuple(queries),
+        tuple(weigh_ins),
+        tuple(plugin_paths),
+        tuple(movement_definitions),
+        None,
+        None,
+        rest_days=tuple(sorted(rest_days, key=lambda r: r.date)),
+        goals=tuple(goals),
     )
 
 
@@ -223,6 +371,10 @@ def show_help():
     console.print(
         "  [green]lint[/green]               - Show parse errors in the log file"
     )
+    console.print("  [green]fmt[/green]                - Format the log file in place")
+    console.print(
+        "  [green]diff[/green] [DATE]        - Compare a planned session against what was logged"
+    )
     console.print("  [green]help[/green]               - Show this help message")
     console.print("  [green]exit[/green] or [green]quit[/green]     - Exit the program")
     console.print()
@@ -253,6 +405,8 @@ def render_result(result):
         for row in result.rows:
             table.add_row(*(str(v) for v in row))
         console.print(table)
+        if result.note:
+            console.print(f"[yellow]Note: {result.note}[/yellow]")
         console.print(f"\n[dim]{len(result.rows)} row(s)[/dim]\n")
     elif isinstance(result, TextResult):
         console.print(result.text)
@@ -365,6 +519,8 @@ def cli(file):
         "tables",
         "reload",
         "lint",
+        "fmt",
+        "diff",
         "help",
         "exit",
         "quit",
@@ -463,6 +619,8 @@ def cli(file):
                         "tables",
                         "reload",
                         "lint",
+                        "fmt",
+                        "diff",
                         "help",
                         "exit",
                         "quit",
@@ -471,12 +629,58 @@ def cli(file):
                 except Exception as e:
                     console.print(f"[red]✗[/red] Error reloading file: {e}\n")
 
+            elif command == "fmt":
+                src = Path(file).read_text()
+                formatted = format_source(src)
+                if formatted == src:
+                    console.print("[green]Already formatted.[/green]\n")
+                else:
+                    Path(file).write_text(formatted)
+                    console.print(
+                        f"[green]✓[/green] Formatted {file}. Run 'reload' to pick up changes.\n"
+                    )
+
+            elif command == "diff":
+                if args.strip():
+                    try:
+                        target = datetime.strptime(args.strip(), "%Y-%m-%d").date()
+                    except ValueError:
+                        console.print("[yellow]Usage: diff [YYYY-MM-DD][/yellow]")
+                        continue
+                    dates = [target]
+                else:
+                    planned_dates = {s.date for s in log.planned_sessions}
+                    completed_dates = {s.date for s in log.completed_sessions}
+                    dates = sorted(planned_dates & completed_dates)
+                    if not dates:
+                        console.print(
+                            "[yellow]No dates with both a planned and a logged session.[/yellow]\n"
+                        )
+                        continue
+                for target in dates:
+                    planned = next(
+                        (s for s in log.planned_sessions if s.date == target), None
+                    )
+                    actual = next(
+                        (s for s in log.completed_sessions if s.date == target), None
+                    )
+                    if planned is None or actual is None:
+                        missing = "planned" if planned is None else "logged"
+                        console.print(
+                            f"[yellow]No {missing} session on {target}.[/yellow]"
+                        )
+                    else:
+                        console.print(plan_vs_actual(planned, actual).to_text())
+                console.print()
+
             elif command == "lint":
                 if not log.diagnostics:
                     console.print("[green]No parse errors found.[/green]\n")
                 else:
                     for d in log.diagnostics:
                         console.print(f"Line {d.line}, col {d.col}: {d.message}")
+                        if d.snippet:
+                            console.print(f"    {d.snippet}")
                     console.print()
 
             elif command in PLUGINS:
@@ -1,10 +1,14 @@
 """Data structures for training logs."""
 
-from dataclasses import dataclass, field
-from datetime import datetime, date, time
+import re
+from dataclasses import dataclass, field, replace
+from difflib import SequenceMatcher
+from datetime import datetime, date, time, timedelta
 from typing import Optional, List, Iterator
 from pint import Quantity
 
+from ox.units import compare_weights
+
 DATE_FORMAT = "%Y-%m-%d"
 
 
@@ -16,6 +20,7 @@ class Diagnostic:
     end_col: int
     message: str
     severity: str  # "error" | "warning"
+    snippet: Optional[str] = None  # raw text of the offending line
 
 
 @dataclass(frozen=True, slots=True)
@@ -29,6 +34,42 @@ class Note:
         return f'{self.date.strftime(DATE_FORMAT)} note "{self.text}"'
 
 
+@dataclass(frozen=True, slots=True)
+class RestDay:
+    """An intentional rest day marked in the log.
+
+    Marked rest days don't break training streaks (see
+    analysis.consistency); unmarked gaps do.
+    """
+
+    date: date
+    note: Optional[str] = None
+
+    def to_ox(self) -> str:
+        note = f' "{self.note}"' if self.note else ""
+        return f"{self.date.strftime(DATE_FORMAT)} rest{note}"
+
+
+@dataclass(frozen=True, slots=True)
+class Goal:
+    """A declared strength goal for a movement's 1RM.
+
+    Declared with an @goal directive; progress against it is judged by
+    estimated 1RM (see analysis.goal_progress).
+    """
+
+    movement: str
+    target: Quantity
+
+    @property
+    def target_kg(self) -> float:
+        """Target converted to kilograms."""
+        return float(self.target.to("kilogram").magnitude)
+
+    def to_ox(self) -> str:
+        return f"@goal {self.movement} {_format_weight(self.target)}"
+
+
 @dataclass(frozen=True, slots=True)
 class StoredQuery:
     name: str
@@ -36,6 +77,39 @@ class StoredQuery:
     date: date
 
 
+def _normalize_name(name: str) -> str:
+    """Lowercase a movement name and drop hyphens/underscores/spaces."""
+    return re.sub(r"[-_\s]+", "", name.lower())
+
+
+def _is_subsequence(short: str, long: str) -> bool:
+    """True if short's characters appear in long, in order."""
+    it = iter(long)
+    return all(c in it for c in short)
+
+
+def _name_similarity(a: str, b: str) -> float:
+    """Case- and hyphen-insensitive similarity between two movement names.
+
+    Exact normalized matches score 1.0. A short abbreviation (up to four
+    letters) whose letters appear in order within the other name, starting
+    from its first letter, scores at least 0.9 — so "rdl" resolves to
+    "romanian-deadlift" and "ohp" to "overhead-press" confidently.
+    """
+    norm_a, norm_b = _normalize_name(a), _normalize_name(b)
+    if norm_a == norm_b:
+        return 1.0
+    score = SequenceMatcher(None, norm_a, norm_b).ratio()
+    for short, long in ((norm_a, norm_b), (norm_b, norm_a)):
+        if (
+            2 <= len(short) <= 4
+            and short[0] == long[:1]
+            and _is_subsequence(short, long)
+        ):
+            score = max(score, 0.9)
+    return score
+
+
 def _format_weight(weight: Quantity) -> str:
     """Format a Quantity as an ox weight string like '24kg' or '135lb'."""
     unit_map = {"kilogram": "kg", "pound": "lb"}
@@ -82,17 +156,104 @@ class TrainingSet:
 
     Attributes:
         reps: Number of repetitions
-        weight: Weight used (optional), assumes bodyweight if no weight listed
+        weight: Weight used (optional), assumes bodyweight if no weight listed.
+            For bodyweight sets this is the resolved total (bodyweight plus
+            added load) when the file declares @bodyweight, else None
+        rpe: Rating of perceived exertion (1-10, half-points allowed)
+        rir: Reps in reserve
+        bodyweight: True for BW/BW+X sets
+        added_weight: External load on top of bodyweight (the X in BW+X)
+        rest: Rest taken after the set (None when unrecorded, never
+            assumed zero)
+        amrap: True for AMRAP sets; reps holds the achieved count when
+            logged (AMRAP(12)), 0 when still open-ended
+        tags: Set-level tags from brackets (e.g., ("paused",)), independent
+            of the movement's definition tags
+        tempo: Per-rep tempo in seconds as
+            (eccentric, pause, concentric, pause), None when unrecorded
+        rep_min: Lower bound of a programmed rep range (3x(8-10));
+            reps then holds the midpoint as an estimate
+        rep_max: Upper bound of a programmed rep range
+        clusters: Mini-set structure of a cluster set (5x(3+3+3));
+            reps then holds the per-set total
+        warmup: True for sets marked warmup; excluded from working-set
+            analyses (volume, intensity, PRs) by default
+        velocity: Mean bar velocity in m/s from a VBT device (v0.45),
+            None when unrecorded
     """
 
     reps: int
     weight: Optional[Quantity] = None
+    rpe: Optional[float] = None
+    rir: Optional[int] = None
+    bodyweight: bool = False
+    added_weight: Optional[Quantity] = None
+    rest: Optional[timedelta] = None
+    amrap: bool = False
+    tags: tuple[str, ...] = ()
+    tempo: Optional[tuple[int, int, int, int]] = None
+    rep_min: Optional[int] = None
+    rep_max: Optional[int] = None
+    clusters: Optional[tuple[int, ...]] = None
+    warmup: bool = False
+    velocity: Optional[float] = None
+
+    @property
+    def reps_estimated(self) -> bool:
+        """True when reps is a rep-range midpoint rather than a logged count."""
+        return self.rep_min is not None
 
     @property
     def volume(self) -> Optional[Quantity]:
-        """Calculate volume (reps * weight)."""
+        """Calculate volume (reps * weight).
+
+        For plan-only rep ranges this uses the midpoint; check
+        reps_estimated to know the value is an estimate.
+        """
         return self.weight * self.reps if self.weight else None
 
+    @property
+    def weight_kg(self) -> Optional[float]:
+        """Weight converted to kilograms (the canonical unit for analysis).
+
+        The original unit is preserved on `weight`; this is a lossless
+        derived view, not a replacement.
+        """
+        if self.weight is None:
+            return None
+        return float(self.weight.to("kilogram").magnitude)
+
+
+def _format_rest(rest: timedelta) -> str:
+    """Format a rest duration as it appears in .ox source ('2m30s', '3m')."""
+    minutes, seconds = divmod(int(rest.total_seconds()), 60)
+    if minutes and seconds:
+        return f"{minutes}m{seconds}s"
+    if minutes:
+        return f"{minutes}m"
+    return f"{seconds}s"
+
+
+def _rep_str(s: "TrainingSet") -> str:
+    """Format a set's reps as an ox rep token, handling AMRAP, range, and
+    cluster sets."""
+    if s.amrap:
+        return f"AMRAP({s.reps})" if s.reps else "AMRAP"
+    if s.clusters is not None:
+        return "(" + "+".join(str(c) for c in s.clusters) + ")"
+    if s.rep_min is not None:
+        return f"({s.rep_min}-{s.rep_max})"
+    return str(s.reps)
+
+
+def _set_weight_str(s: "TrainingSet") -> str:
+    """Format a set's weight as it appears in .ox source."""
+    if s.bodyweight:
+        if s.added_weight is not None:
+            return f"BW+{_format_weight(s.added_weight)}"
+        return "BW"
+    return _format_weight(s.weight) if s.weight is not None else "BW"
+
 
 @dataclass(frozen=True, slots=True)
 class Movement:
@@ -134,31 +295,58 @@ class Movement:
         """
         parts = []
         if self.sets:
-            weights = [s.weight for s in self.sets]
-            reps = [s.reps for s in self.sets]
+            weight_strs = [_set_weight_str(s) for s in self.sets]
+            rep_strs = [_rep_str(s) for s in self.sets]
 
-            uniform_weight = all(w is None for w in weights) or all(
-                w is not None and w == weights[0] for w in weights
-            )
+            uniform_weight = all(w == weight_strs[0] for w in weight_strs)
 
-            if all(w is None for w in weights):
-                parts.append("BW")
-            elif uniform_weight:
-                parts.append(_format_weight(weights[0]))
+            if uniform_weight:
+                parts.append(weight_strs[0])
             else:
-                parts.append(
-                    "/".join(
-                        _format_weight(w) if w is not None else "BW" for w in weights
-                    )
-                )
+                parts.append("/".join(weight_strs))
 
-            use_compact = all(r == reps[0] for r in reps) and (
+            use_compact = all(r == rep_strs[0] for r in rep_strs) and (
                 compact_reps or uniform_weight
             )
             if use_compact:
-                parts.append(f"{len(reps)}x{reps[0]}")
+                parts.append(f"{len(rep_strs)}x{rep_strs[0]}")
             else:
-                parts.append("/".join(str(r) for r in reps))
+                parts.append("/".join(rep_strs))
+
+            efforts = {(s.rpe, s.rir) for s in self.sets}
+            if len(efforts) == 1:
+                rpe, rir = next(iter(efforts))
+                if rpe is not None:
+                    parts.append(f"@{rpe:g}")
+                elif rir is not None:
+                    parts.append(f"@RIR{rir}")
+
+            rests = {s.rest for s in self.sets}
+            if len(rests) == 1:
+                rest = next(iter(rests))
+                if rest is not None:
+                    parts.append(f"rest {_format_rest(rest)}")
+
+            tempos = {s.tempo for s in self.sets}
+            if len(tempos) == 1:
+                tempo = next(iter(tempos))
+                if tempo is not None:
+                    parts.append(f"tempo {'-'.join(str(t) for t in tempo)}")
+
+            velocities = {s.velocity for s in self.sets}
+            if len(velocities) == 1:
+                velocity = next(iter(velocities))
+                if velocity is not None:
+                    parts.append(f"v{velocity:g}")
+
+            if all(s.warmup for s in self.sets):
+                parts.append("warmup")
+
+            tag_sets = {s.tags for s in self.sets}
+            if len(tag_sets) == 1:
+                tags = next(iter(tag_sets))
+                if tags:
+                    parts.append(f"[{', '.join(tags)}]")
 
         if self.note:
             parts.append(f'"{self.note}"')
@@ -167,6 +355,53 @@ class Movement:
         return f"{self.name}: {detail_str}" if detail_str else f"{self.name}:"
 
 
+@dataclass(frozen=True, slots=True)
+class MovementGroup:
+    """Movements performed together as a superset or circuit.
+
+    Attributes:
+        kind: Grouping style ("superset" or "circuit")
+        movements: Movements in the group, in performed order
+    """
+
+    kind: str
+    movements: tuple[Movement, ...]
+
+    def to_ox(self) -> str:
+        """Serialize to ox format block."""
+        lines = [f"@{self.kind}"]
+        lines.extend(m.to_ox() for m in self.movements)
+        lines.append("@end")
+        return "\n".join(lines)
+
+
+@dataclass(frozen=True, slots=True)
+class PersonalRecord:
+    """Best lifts for a single movement.
+
+    Attributes:
+        movement: Movement name
+        max_weight: Heaviest weight lifted at any rep count
+        max_weight_date: Date the heaviest weight was first lifted
+        max_weight_set: The set that produced the heaviest weight
+        best_e1rm_kg: Best estimated 1RM (Brzycki), in kilograms
+        best_e1rm_date: Date the best estimated 1RM was first achieved
+        best_e1rm_set: The set that produced the best estimated 1RM
+        max_reps: Most reps in a single set (bodyweight-only movements)
+        max_reps_date: Date the rep record was first achieved
+    """
+
+    movement: str
+    max_weight: Optional[Quantity] = None
+    max_weight_date: Optional[date] = None
+    max_weight_set: Optional[TrainingSet] = None
+    best_e1rm_kg: Optional[float] = None
+    best_e1rm_date: Optional[date] = None
+    best_e1rm_set: Optional[TrainingSet] = None
+    max_reps: Optional[int] = None
+    max_reps_date: Optional[date] = None
+
+
 @dataclass(frozen=True, slots=True)
 class MovementDefinition:
     """A movement definition from an @movement block.
@@ -192,7 +427,9 @@ class TrainingSession(Entry):
 
     Attributes:
         name: Session name (e.g., "Upper Day"), None for single-line entries
-        movements: Tuple of Movement objects
+        movements: Tuple of Movement objects (grouped movements included,
+            flattened in performed order)
+        groups: Superset/circuit groupings of movements in this session
         date: Inherited from Entry
         flag: Inherited from Entry
     """
@@ -200,6 +437,7 @@ class TrainingSession(Entry):
     name: str = field()
     movements: tuple[Movement, ...]
     notes: tuple[Note, ...] = ()
+    groups: tuple[MovementGroup, ...] = ()
 
     def to_ox(self) -> str:
         """Serialize to ox format string."""
@@ -211,8 +449,17 @@ class TrainingSession(Entry):
             lines.append(f"{date_str} {self.flag} {self.name}")
             for n in self.notes:
                 lines.append(f'note: "{n.text}"')
+            # movements is the flattened order; emit each group's block in
+            # place of its first member and skip the rest
+            group_for = {id(m): g for g in self.groups for m in g.movements}
+            emitted: set[int] = set()
             for m in self.movements:
-                lines.append(m.to_ox())
+                g = group_for.get(id(m))
+                if g is None:
+                    lines.append(m.to_ox())
+                elif id(g) not in emitted:
+                    emitted.add(id(g))
+                    lines.append(g.to_ox())
             lines.append("@end")
             return "\n".join(lines)
 
@@ -234,6 +481,10 @@ class TrainingLog:
     weigh_ins: tuple[WeighIn, ...] = field(default_factory=tuple)
     plugin_paths: tuple[str, ...] = field(default_factory=tuple)
     movement_definitions: tuple[MovementDefinition, ...] = field(default_factory=tuple)
+    default_unit: Optional[str] = None  # from an @units directive, e.g. "lb"
+    bodyweight: Optional[Quantity] = None  # from an @bodyweight directive
+    rest_days: tuple[RestDay, ...] = field(default_factory=tuple)
+    goals: tuple[Goal, ...] = field(default_factory=tuple)
 
     @property
     def completed_sessions(self) -> tuple[TrainingSession, ...]:
@@ -253,6 +504,258 @@ class TrainingLog:
         """
         return tuple(s for s in self.sessions if s.flag == "!")
 
+    def filter(
+        self,
+        movement: Optional[str] = None,
+        tag: Optional[str] = None,
+        start: Optional[date] = None,
+        end: Optional[date] = None,
+    ) -> tuple["TrainingSession", ...]:
+        """Filter sessions by movement name, tag, and inclusive date range.
+
+        A tag matches through movement definitions or set-level bracket
+        tags, so filtering by "hip-hinge" returns sessions containing any
+        movement whose @movement block carries that tag, and filtering by
+        "paused" catches sets tagged [paused] regardless of definitions.
+        None arguments mean "no constraint".
+
+        Args:
+            movement: Exact movement name to match
+            tag: Movement definition tag to match (any movement in session)
+            start: Earliest session date (inclusive)
+            end: Latest session date (inclusive)
+
+        Returns:
+            Matching sessions in chronological order
+        """
+        tagged_names = None
+        if tag is not None:
+            tagged_names = {d.name for d in self.movement_definitions if tag in d.tags}
+
+        def keep(session: "TrainingSession") -> bool:
+            if start is not None and session.date < start:
+                return False
+            if end is not None and session.date > end:
+                return False
+            names = {m.name for m in session.movements}
+            if movement is not None and movement not in names:
+                return False
+            if tagged_names is not None and not (names & tagged_names):
+                if not any(tag in s.tags for m in session.movements for s in m.sets):
+                    return False
+            return True
+
+        return tuple(
+            sorted((s for s in self.sessions if keep(s)), key=lambda s: s.date)
+        )
+
+    def coalesce(self) -> tuple["TrainingLog", int]:
+        """Merge consecutive same-movement, same-weight lines in each session.
+
+        Logging sets at different times tends to leave two lines for the
+        same movement; this folds them into one Movement with the combined
+        sets. Lines only merge when every set on both lines uses the same
+        weight — deliberate top-set + back-off pairs stay separate. It is
+        opt-in: parsing never coalesces automatically. Sessions with
+        superset/circuit groups are left untouched, since their line
+        order is structural.
+
+        Returns:
+            Tuple of (new log, number of merges performed). The original
+            log is unchanged.
+        """
+
+        def line_weight(m: Movement):
+            kgs = {s.weight_kg for s in m.sets}
+            return next(iter(kgs)) if len(kgs) == 1 else object()
+
+        merges = 0
+        new_sessions = []
+        for session in self.sessions:
+            if session.groups:
+                new_sessions.append(session)
+                continue
+            merged: list[Movement] = []
+            for m in session.movements:
+                prev = merged[-1] if merged else None
+                if (
+                    prev is not None
+                    and prev.name == m.name
+                    and line_weight(prev) == line_weight(m)
+                ):
+                    notes = [n for n in (prev.note, m.note) if n]
+                    merged[-1] = Movement(
+                        name=prev.name,
+                        sets=list(prev.sets) + list(m.sets),
+                        note="; ".join(dict.fromkeys(notes)) or None,
+                    )
+                    merges += 1
+                else:
+                    merged.append(m)
+            if len(merged) == len(session.movements):
+                new_sessions.append(session)
+            else:
+                new_sessions.append(replace(session, movements=tuple(merged)))
+        return replace(self, sessions=tuple(new_sessions)), merges
+
+    def personal_records(self) -> dict[str, PersonalRecord]:
+        """Detect personal records per movement across completed sessions.
+
+        For each movement, finds the heaviest weight lifted at any rep
+        count and the best estimated 1RM (Brzycki), with the date and set
+        that produced each. Ties resolve to the earliest date (first time
+        achieved). Movements with only bodyweight sets are reported by max
+        reps instead. Sets marked warmup never set records, and neither
+        do open-ended AMRAP sets with no achieved reps logged. Weights are
+        compared in canonical kilograms within a small epsilon
+        (ox.units.KG_EPSILON), so lb/kg conversion noise never creates
+        phantom records.
+
+        Returns:
+            Dict of movement name to PersonalRecord
+        """
+        from ox.builtins.e1rm import one_rep_max
+
+        raw: dict[str, dict] = {}
+        for session in sorted(self.completed_sessions, key=lambda s: s.date):
+            for movement in session.movements:
+                rec = raw.setdefault(movement.name, {})
+                for training_set in movement.sets:
+                    if training_set.warmup:
+                        continue
+                    if training_set.reps < 1:
+                        # Open-ended AMRAP: no achieved reps logged yet
+                        continue
+                    if training_set.weight is None:
+                        if training_set.reps > rec.get("max_reps", 0):
+                            rec["max_reps"] = training_set.reps
+                            rec["max_reps_date"] = session.date
+                        continue
+                    kg = training_set.weight_kg
+                    if compare_weights(kg, rec.get("max_weight_kg", 0.0)) > 0:
+                        rec["max_weight_kg"] = kg
+                        rec["max_weight"] = training_set.weight
+                        rec["max_weight_date"] = session.date
+                        rec["max_weight_set"] = training_set
+                    e1rm = one_rep_max(kg, training_set.reps)
+                    if compare_weights(e1rm, rec.get("best_e1rm_kg", 0.0)) > 0:
+                        rec["best_e1rm_kg"] = e1rm
+                        rec["best_e1rm_date"] = session.date
+                        rec["best_e1rm_set"] = training_set
+
+        return {
+            name: PersonalRecord(
+                movement=name,
+                max_weight=rec.get("max_weight"),
+                max_weight_date=rec.get("max_weight_date"),
+                max_weight_set=rec.get("max_weight_set"),
+                best_e1rm_kg=rec.get("best_e1rm_kg"),
+                best_e1rm_date=rec.get("best_e1rm_date"),
+                best_e1rm_set=rec.get("best_e1rm_set"),
+                max_reps=rec.get("max_reps"),
+                max_reps_date=rec.get("max_reps_date"),
+            )
+            for name, rec in raw.items()
+        }
+
+    def resolve_movement(self, name: str) -> tuple[str, float]:
+        """Find the nearest-matching known movement name.
+
+        Matches case- and hyphen-insensitively against movement
+        definitions and logged movement names, so "RDL" or "benchpress"
+        resolve to their canonical spellings. Definitions are preferred
+        over logged names on ties.
+
+        Args:
+            name: Movement name as typed
+
+        Returns:
+            (canonical name, confidence in 0..1); (name, 0.0) when
+            nothing is known to match against
+        """
+        candidates = [d.name for d in self.movement_definitions]
+        seen = set(candidates)
+        for session in self.sessions:
+            for movement in session.movements:
+                if movement.name not in seen:
+                    seen.add(movement.name)
+                    candidates.append(movement.name)
+        if not candidates:
+            return name, 0.0
+        best = max(candidates, key=lambda c: _name_similarity(name, c))
+        return best, _name_similarity(name, best)
+
+    def find_inconsistent_names(self, cutoff: float = 0.85) -> list[list[str]]:
+        """Cluster likely-duplicate movement names across the log.
+
+        Names whose pairwise similarity reaches the cutoff land in the
+        same cluster (transitively), so "bench-press" / "Bench-Press" /
+        "benchpress" come back together for cleanup.
+
+        Args:
+            cutoff: Minimum similarity to consider two names duplicates
+
+        Returns:
+            Clusters of two or more names, each sorted, sorted by first
+            member
+        """
+        names = sorted({m.name for s in self.sessions for m in s.movements})
+        parent = {n: n for n in names}
+
+        def find(n: str) -> str:
+            while parent[n] != n:
+                parent[n] = parent[parent[n]]
+                n = parent[n]
+            return n
+
+        for i, a in enumerate(names):
+            for b in names[i + 1 :]:
+                if _name_similarity(a, b) >= cutoff:
+                    parent[find(a)] = find(b)
+
+        clusters: dict[str, list[str]] = {}
+        for n in names:
+            clusters.setdefault(find(n), []).append(n)
+        return sorted(sorted(c) for c in clusters.values() if len(c) > 1)
+
+    def write_csv(self, fileobj) -> None:
+        """Write the log as CSV, one row per set.
+
+        Columns are date, movement, weight_kg, sets (1-based set number
+        within the movement), reps, rpe, rir, and tags (semicolon-joined,
+        from @movement definitions). Optional fields are left blank. The
+        header is stable — downstream scripts depend on it.
+
+        Args:
+            fileobj: Writable text file object
+        """
+        import csv
+
+        tags_for = {d.name: d.tags for d in self.movement_definitions}
+        writer = csv.writer(fileobj)
+        writer.writerow(
+            ["date", "movement", "weight_kg", "sets", "reps", "rpe", "rir", "tags"]
+        )
+        for session in sorted(self.sessions, key=lambda s: s.date):
+            for movement in session.movements:
+                tags = ";".join(tags_for.get(movement.name, ()))
+                for i, training_set in enumerate(movement.sets, start=1):
+                    kg = training_set.weight_kg
+                    writer.writerow(
+                        [
+                            session.date.strftime(DATE_FORMAT),
+                            movement.name,
+                            f"{kg:g}" if kg is not None else "",
+                            i,
+                            training_set.reps,
+                            f"{training_set.rpe:g}"
+                            if training_set.rpe is not None
+                            else "",
+                            training_set.rir if training_set.rir is not None else "",
+                            tags,
+                        ]
+                    )
+
     def movements(self, name: Optional[str] = None) -> Iterator[tuple[date, Movement]]:
         """Iterate over movements, optionally filtered by name.
 
@@ -288,3 +791,56 @@ class TrainingLog:
             Tuple of (date, Movement) for most recent session
         """
         return self.movement_history(name)[-1]
+
+    def best_at_reps(
+        self, movement: str, reps: int
+    ) -> Optional[tuple[date, "TrainingSet"]]:
+        """Heaviest set logged for a movement at exactly a rep count.
+
+        The coach's "what's your best triple?" — the actual logged set,
+        not an e1RM estimate. Only completed sessions count; warmup sets,
+        sets without a resolved weight, and rep-range sets (whose rep
+        count is an estimate) are skipped. Ties resolve to the earliest
+        date.
+
+        Args:
+            movement: Exercise name
+            reps: Exact rep count to match
+
+        Returns:
+            Tuple of (date, set), or None when no matching set exists
+        """
+        return self.best_at_reps_range(movement, reps, reps)
+
+    def best_at_reps_range(
+        self, movement: str, rep_min: int, rep_max: int
+    ) -> Optional[tuple[date, "TrainingSet"]]:
+        """Heaviest set logged for a movement within an inclusive rep range.
+
+        Same rules as best_at_reps with the rep count matched against
+        [rep_min, rep_max].
+
+        Args:
+            movement: Exercise name
+            rep_min: Lowest rep count to match (inclusive)
+            rep_max: Highest rep count to match (inclusive)
+
+        Returns:
+            Tuple of (date, set), or None when no matching set exists
+        """
+        best: Optional[tuple[date, TrainingSet]] = None
+        for session in sorted(self.completed_sessions, key=lambda s: s.date):
+            for m in session.movements:
+                if m.name != movement:
+                    continue
+                for s in m.sets:
+                    if s.warmup or s.reps_estimated or s.weight_kg is None:
+                        continue
+                    if not rep_min <= s.reps <= rep_max:
+                        continue
+                    if (
+                        best is None
+                        or compare_weights(s.weight_kg, best[1].weight_kg) > 0
+                    ):
+                        best = (session.date, s)
+        return best
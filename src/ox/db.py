@@ -30,6 +30,9 @@ CREATE TABLE sets (
     reps INTEGER NOT NULL,
     weight_magnitude REAL,
     weight_unit TEXT,
+    rpe REAL,
+    rir INTEGER,
+    warmup INTEGER NOT NULL DEFAULT 0,
     FOREIGN KEY (movement_id) REFERENCES movements(id)
 );
 
@@ -87,7 +90,10 @@ SELECT
     t.id AS set_id,
     t.reps,
     t.weight_magnitude,
-    t.weight_unit
+    t.weight_unit,
+    t.rpe,
+    t.rir,
+    t.warmup
 FROM sessions s
 JOIN movements m ON m.session_id = s.id
 JOIN sets t ON t.movement_id = m.id;
@@ -137,8 +143,16 @@ def create_db(log: TrainingLog) -> sqlite3.Connection:
             for training_set in movement.sets:
                 mag, unit = _decompose_weight(training_set.weight)
                 conn.execute(
-                    "INSERT INTO sets (movement_id, reps, weight_magnitude, weight_unit) VALUES (?, ?, ?, ?)",
-                    (movement_id, training_set.reps, mag, unit),
+                    "INSERT INTO sets (movement_id, reps, weight_magnitude, weight_unit, rpe, rir, warmup) VALUES (?, ?, ?, ?, ?, ?, ?)",
+                    (
+                        movement_id,
+                        training_set.reps,
+                        mag,
+                        unit,
+                        training_set.rpe,
+                        training_set.rir,
+                        int(training_set.warmup),
+                    ),
                 )
 
         for note in session.notes:
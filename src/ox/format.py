@@ -0,0 +1,125 @@
+"""Formatter for .ox files, in the spirit of gofmt.
+
+Normalizes token spacing, aligns movement details within a block,
+lowercases and hyphenates movement names, and canonicalizes spelled-out
+units ("135pound" → "135lb"), while preserving comments and blank-line
+grouping. Formatting is idempotent: running it twice produces the same
+bytes as running it once.
+"""
+
+import re
+
+# Spelled-out unit names worth shortening to their ox-canonical symbols.
+_UNIT_CANON = {
+    "kilogram": "kg",
+    "pound": "lb",
+    "gram": "g",
+    "ounce": "oz",
+    "tonne": "t",
+    "carat": "ct",
+}
+_UNIT_RE = re.compile(r"(?<=\d)(kilogram|pound|gram|ounce|tonne|carat)(?![a-z])")
+
+_TOKEN = re.compile(r'"[^"]*"|\S+')
+_FLAGS = {"*", "!", "W"}
+_ISO_DATE = re.compile(r"^\d{4}-\d{2}-\d{2}$")
+
+
+def _canon_units(token: str) -> str:
+    """Canonicalize spelled-out units inside a non-quoted token."""
+    if token.startswith('"'):
+        return token
+    return _UNIT_RE.sub(lambda m: _UNIT_CANON[m.group(1)], token)
+
+
+def _normalize_movement(name: str) -> str:
+    """Lowercase a movement name and turn underscores into hyphens."""
+    return name.lower().replace("_", "-")
+
+
+def _split_comment(line: str) -> tuple[str, str | None]:
+    """Split an inline comment off a line, respecting quoted strings."""
+    in_quote = False
+    for i, ch in enumerate(line):
+        if ch == '"':
+            in_quote = not in_quote
+        elif ch == "#" and not in_quote:
+            return line[:i], line[i:].rstrip()
+    return line, None
+
+
+def _format_line(line: str) -> tuple[str, str, str]:
+    """Format one line.
+
+    Returns:
+        (kind, head, rest) where kind is "blank", "comment", "item"
+        (head is the "name:" part, rest the details), or "other" (head
+        holds the whole line)
+    """
+    stripped = line.strip()
+    if not stripped:
+        return "blank", "", ""
+    if stripped.startswith("#"):
+        return "comment", stripped, ""
+
+    code, comment = _split_comment(line)
+    tokens = _TOKEN.findall(code)
+    # Re-attach a detached colon to its name token
+    if len(tokens) >= 2 and tokens[1] == ":":
+        tokens = [tokens[0] + ":"] + tokens[2:]
+
+    tokens = [_canon_units(t) for t in tokens]
+    suffix = f" {comment}" if comment else ""
+
+    # Single-line entry: date flag item: details
+    if (
+        len(tokens) >= 3
+        and _ISO_DATE.match(tokens[0])
+        and tokens[1] in _FLAGS
+        and tokens[2].endswith(":")
+    ):
+        tokens[2] = _normalize_movement(tokens[2])
+        return "other", " ".join(tokens) + suffix, ""
+
+    # Item line inside a block: item: details
+    if not tokens[0].startswith("@") and tokens[0].endswith(":") and tokens[0] != ":":
+        head = _normalize_movement(tokens[0]) if tokens[0] != "note:" else tokens[0]
+        return "item", head, " ".join(tokens[1:]) + suffix
+
+    return "other", " ".join(tokens) + suffix, ""
+
+
+def format_source(src: str) -> str:
+    """Format ox source text.
+
+    Args:
+        src: Raw .ox file contents
+
+    Returns:
+        Formatted text (always newline-terminated when non-empty)
+    """
+    lines = [_format_line(line) for line in src.split("\n")]
+
+    # Align details within each contiguous run of item lines so the
+    # weight/rep columns line up.
+    out: list[str] = []
+    i = 0
+    while i < len(lines):
+        kind, head, rest = lines[i]
+        if kind != "item":
+            out.append(head)
+            i += 1
+            continue
+        run_end = i
+        while run_end < len(lines) and lines[run_end][0] == "item":
+            run_end += 1
+        width = max(len(lines[j][1]) for j in range(i, run_end))
+        for j in range(i, run_end):
+            _, name, details = lines[j]
+            out.append(f"{name.ljust(width)} {details}".rstrip())
+        i = run_end
+
+    text = "\n".join(out)
+    if text and not text.endswith("\n"):
+        text += "\n"
+    return text
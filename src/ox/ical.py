@@ -0,0 +1,94 @@
+"""iCalendar export for training sessions.
+
+Writes one all-day VEVENT per session (ox doesn't track time-of-day) so a
+planned block — e.g. the output of the program expander — can be dropped
+onto a phone calendar. Output follows RFC 5545: CRLF line endings, text
+escaping, and 75-octet line folding.
+"""
+
+import re
+from typing import Iterable, TextIO
+
+from ox.data import TrainingSession
+
+_PRODID = "-//ox//training log//EN"
+
+
+def _escape(text: str) -> str:
+    """Escape text per RFC 5545 (backslash, semicolon, comma, newline)."""
+    return (
+        text.replace("\\", "\\\\")
+        .replace(";", "\\;")
+        .replace(",", "\\,")
+        .replace("\n", "\\n")
+    )
+
+
+def _fold(line: str) -> list[str]:
+    """Fold a content line to 75 octets, continuations indented one space."""
+    encoded = line.encode("utf-8")
+    if len(encoded) <= 75:
+        return [line]
+    out = []
+    while encoded:
+        chunk = encoded[:75]
+        # Don't split a multi-byte character
+        while True:
+            try:
+                out.append(chunk.decode("utf-8"))
+                break
+            except UnicodeDecodeError:
+                chunk = chunk[:-1]
+        encoded = encoded[len(chunk) :]
+        if encoded:
+            encoded = b" " + encoded
+    return out
+
+
+def _uid(session: TrainingSession, index: int) -> str:
+    """Deterministic UID so re-imports update rather than duplicate."""
+    slug = re.sub(r"[^a-z0-9]+", "-", (session.name or "session").lower()).strip("-")
+    return f"{session.date.strftime('%Y%m%d')}-{index}-{slug}@ox"
+
+
+def _event_lines(session: TrainingSession, index: int) -> list[str]:
+    date_str = session.date.strftime("%Y%m%d")
+    summary = session.name or ", ".join(m.name for m in session.movements)
+    if session.name and session.movements:
+        summary += ": " + ", ".join(m.name for m in session.movements)
+    description = "\n".join(m.to_ox() for m in session.movements)
+    return [
+        "BEGIN:VEVENT",
+        f"UID:{_uid(session, index)}",
+        f"DTSTAMP:{date_str}T000000Z",
+        f"DTSTART;VALUE=DATE:{date_str}",
+        f"SUMMARY:{_escape(summary)}",
+        f"DESCRIPTION:{_escape(description)}",
+        "END:VEVENT",
+    ]
+
+
+def to_ics(sessions: Iterable[TrainingSession]) -> str:
+    """Render sessions as an iCalendar document.
+
+    Args:
+        sessions: Sessions to export, typically log.planned_sessions or
+            an expanded program
+
+    Returns:
+        RFC 5545 text with one all-day VEVENT per session
+    """
+    lines = [
+        "BEGIN:VCALENDAR",
+        "VERSION:2.0",
+        f"PRODID:{_PRODID}",
+    ]
+    for i, session in enumerate(sessions):
+        lines.extend(_event_lines(session, i))
+    lines.append("END:VCALENDAR")
+    return "\r\n".join(folded for line in lines for folded in _fold(line)) + "\r\n"
+
+
+def write_ics(sessions: Iterable[TrainingSession], out: TextIO) -> None:
+    """Write sessions to a stream as an iCalendar document."""
+    out.write(to_ics(sessions))
@@ -0,0 +1,105 @@
+"""Importers for third-party training app exports."""
+
+import csv
+import re
+from dataclasses import dataclass
+from datetime import datetime
+
+from ox.data import Movement, TrainingLog, TrainingSession, TrainingSet
+from ox.units import ureg
+
+# Strong embeds the unit in the weight column header, e.g. "Weight (kg)"
+_HEADER_UNIT = re.compile(r"^Weight \((\w+)\)$")
+
+
+@dataclass(frozen=True, slots=True)
+class ImportResult:
+    """Result of importing an external export.
+
+    Attributes:
+        log: The imported sessions as a TrainingLog
+        unmapped_exercises: Original exercise names whose slug did not match
+            a known movement (still imported, not dropped)
+    """
+
+    log: TrainingLog
+    unmapped_exercises: tuple[str, ...]
+
+
+def slugify(name: str) -> str:
+    """Convert an exercise name to ox movement conventions.
+
+    "Bench Press (Barbell)" → "bench-press-barbell"
+    """
+    return re.sub(r"[^a-z0-9]+", "-", name.lower()).strip("-")
+
+
+def import_strong_csv(fileobj, known_movements=None) -> ImportResult:
+    """Import a Strong app CSV export into a TrainingLog.
+
+    Maps Strong's columns (Date, Workout Name, Exercise Name, Set Order,
+    Weight, Reps) into sessions and sets. Exercise names are slugified to
+    ox movement conventions; the weight unit comes from a per-row
+    "Weight Unit" column or the weight column header ("Weight (kg)"),
+    defaulting to kg.
+
+    Args:
+        fileobj: Readable text file object with the CSV export
+        known_movements: Optional iterable of movement names; imported
+            slugs not in it are reported as unmapped
+
+    Returns:
+        ImportResult with the log and any unmapped exercise names
+    """
+    reader = csv.DictReader(fileobj)
+
+    weight_col = "Weight"
+    header_unit = None
+    for col in reader.fieldnames or ():
+        m = _HEADER_UNIT.match(col)
+        if m:
+            weight_col, header_unit = col, m.group(1)
+
+    known = set(known_movements) if known_movements is not None else None
+    unmapped: list[str] = []
+    # (date, workout name) -> slug -> list of (set order, TrainingSet)
+    sessions: dict[tuple, dict[str, list]] = {}
+
+    for row in reader:
+        raw_date = row["Date"].strip()
+        entry_date = datetime.fromisoformat(raw_date).date()
+        slug = slugify(row["Exercise Name"])
+        if known is not None and slug not in known:
+            if row["Exercise Name"] not in unmapped:
+                unmapped.append(row["Exercise Name"])
+
+        unit = row.get("Weight Unit") or header_unit or "kg"
+        raw_weight = (row.get(weight_col) or "").strip()
+        weight = None
+        if raw_weight and float(raw_weight) > 0:
+            weight = float(raw_weight) * ureg.parse_units(unit)
+
+        order = int(float(row.get("Set Order") or 0))
+        training_set = TrainingSet(reps=int(float(row["Reps"])), weight=weight)
+
+        key = (entry_date, row["Workout Name"].strip())
+        sessions.setdefault(key, {}).setdefault(slug, []).append((order, training_set))
+
+    built = []
+    for (entry_date, name), by_movement in sorted(sessions.items()):
+        movements = tuple(
+            Movement(
+                name=slug,
+                sets=[s for _, s in sorted(sets, key=lambda pair: pair[0])],
+                note=None,
+            )
+            for slug, sets in by_movement.items()
+        )
+        built.append(
+            TrainingSession(date=entry_date, flag="*", name=name, movements=movements)
+        )
+
+    return ImportResult(
+        log=TrainingLog(sessions=tuple(built)),
+        unmapped_exercises=tuple(unmapped),
+    )
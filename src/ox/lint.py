@@ -1,35 +1,51 @@
 """Lint utilities for ox training log files."""
 
+import re
+
 from ox.data import Diagnostic
 
+_BARE_NUMBER = re.compile(r"^\d+(\.\d+)?$")
+_ISO_DATE = re.compile(r"^\d{4}-\d{2}-\d{2}$")
+
+# Fields process_movement_block understands; anything else is a typo.
+_DEFINITION_FIELDS = {"equipment", "tags", "tag", "note", "url"}
+
+
+def _source_lines(tree) -> list[str]:
+    """Split a tree's source text into lines for snippet extraction."""
+    return tree.root_node.text.decode("utf-8").split("\n")
+
+
+def _snippet(lines: list[str], row: int) -> str | None:
+    """Return the raw text of a 0-based source row, if it exists."""
+    return lines[row] if 0 <= row < len(lines) else None
+
+
+def _diagnostic(node, lines: list[str], message: str, severity: str) -> Diagnostic:
+    """Build a Diagnostic from a node's position, with the offending line."""
+    return Diagnostic(
+        line=node.start_point[0] + 1,
+        col=node.start_point[1],
+        end_line=node.end_point[0] + 1,
+        end_col=node.end_point[1],
+        message=message,
+        severity=severity,
+        snippet=_snippet(lines, node.start_point[0]),
+    )
+
 
 def collect_diagnostics(tree) -> tuple[Diagnostic, ...]:
     """Walk a tree-sitter tree and collect ERROR/MISSING nodes as Diagnostics."""
     diagnostics = []
+    lines = _source_lines(tree)
 
     def visit(node):
         if node.type == "ERROR":
-            diagnostics.append(
-                Diagnostic(
-                    line=node.start_point[0] + 1,
-                    col=node.start_point[1],
-                    end_line=node.end_point[0] + 1,
-                    end_col=node.end_point[1],
-                    message="Syntax error",
-                    severity="error",
-                )
-            )
+            diagnostics.append(_diagnostic(node, lines, "Syntax error", "error"))
             return  # don't recurse into ERROR subtrees
         if node.is_missing:
             diagnostics.append(
-                Diagnostic(
-                    line=node.start_point[0] + 1,
-                    col=node.start_point[1],
-                    end_line=node.end_point[0] + 1,
-                    end_col=node.end_point[1],
-                    message=f"Missing {node.type}",
-                    severity="error",
-                )
+                _diagnostic(node, lines, f"Missing {node.type}", "error")
             )
             return
         for child in node.children:
@@ -37,3 +53,138 @@ def collect_diagnostics(tree) -> tuple[Diagnostic, ...]:
 
     visit(tree.root_node)
     return tuple(diagnostics)
+
+
+def _validate_movement_block(node, lines, seen: set, diagnostics: list) -> None:
+    """Validate one @movement block: name uniqueness, fields, tag lists."""
+    name_node = node.child_by_field_name("name")
+    if name_node is None:
+        return
+    name = name_node.text.decode("utf-8")
+    if name in seen:
+        diagnostics.append(
+            _diagnostic(
+                name_node,
+                lines,
+                f"Duplicate @movement definition '{name}'",
+                "warning",
+            )
+        )
+    seen.add(name)
+
+    for child in node.children:
+        if child.type != "metadata_line":
+            continue
+        key_node = child.child_by_field_name("key")
+        if key_node is None:
+            continue
+        key = key_node.text.decode("utf-8")
+        if key not in _DEFINITION_FIELDS:
+            diagnostics.append(
+                _diagnostic(
+                    key_node,
+                    lines,
+                    f"Unknown field '{key}' in @movement {name}",
+                    "warning",
+                )
+            )
+            continue
+        value_node = child.child_by_field_name("value")
+        if key in ("tags", "tag") and value_node is not None:
+            for tag in value_node.text.decode("utf-8").split(","):
+                tag = tag.strip()
+                if not tag:
+                    diagnostics.append(
+                        _diagnostic(
+                            value_node,
+                            lines,
+                            f"Empty tag in '{key}' of @movement {name}",
+                            "warning",
+                        )
+                    )
+                elif '"' in tag or "'" in tag:
+                    diagnostics.append(
+                        _diagnostic(
+                            value_node,
+                            lines,
+                            f"Tag {tag} in '{key}' of @movement {name} "
+                            "should not be quoted",
+                            "warning",
+                        )
+                    )
+
+
+def collect_semantic_diagnostics(tree) -> tuple[Diagnostic, ...]:
+    """Walk a tree-sitter tree and collect semantic problems the grammar accepts.
+
+    Checks:
+    - effort annotations: RPE values must be between 1 and 10
+    - unitless weights: only allowed when the file declares an @units default
+    - dates: non-ISO formats are accepted but warned about
+    - percentage loads: only allowed inside @template blocks
+    - @movement definitions: duplicate names, unknown fields, malformed
+      tag lists (empty or quoted tags)
+    """
+    diagnostics = []
+    lines = _source_lines(tree)
+    has_units_directive = any(
+        child.type == "units_directive" for child in tree.root_node.children
+    )
+    seen_definitions: set[str] = set()
+
+    def visit(node, in_template=False):
+        if node.type == "template_block":
+            in_template = True
+        if node.type == "percent" and not in_template:
+            diagnostics.append(
+                _diagnostic(
+                    node,
+                    lines,
+                    "Percentage loads are only allowed inside @template blocks",
+                    "error",
+                )
+            )
+        if (
+            node.type == "weight"
+            and not has_units_directive
+            and _BARE_NUMBER.match(node.text.decode("utf-8"))
+        ):
+            diagnostics.append(
+                _diagnostic(
+                    node,
+                    lines,
+                    "Unitless weight requires an @units directive",
+                    "error",
+                )
+            )
+        if node.type == "date":
+            raw = node.text.decode("utf-8")
+            if not _ISO_DATE.match(raw):
+                diagnostics.append(
+                    _diagnostic(
+                        node,
+                        lines,
+                        f"Ambiguous date format {raw!r}; prefer YYYY-MM-DD",
+                        "warning",
+                    )
+                )
+        if node.type == "movement_block":
+            _validate_movement_block(node, lines, seen_definitions, diagnostics)
+        if node.type == "effort":
+            raw = node.text.decode("utf-8")[1:]  # strip leading @
+            if not raw.upper().startswith("RIR"):
+                value = float(raw)
+                if not 1 <= value <= 10:
+                    diagnostics.append(
+                        _diagnostic(
+                            node,
+                            lines,
+                            f"RPE must be between 1 and 10, got {value:g}",
+                            "error",
+                        )
+                    )
+        for child in node.children:
+            visit(child, in_template)
+
+    visit(tree.root_node)
+    return tuple(diagnostics)
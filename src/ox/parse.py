@@ -1,12 +1,15 @@
 """Parse tree-sitter nodes into training data structures."""
 
 from tree_sitter import Node
-from datetime import datetime
+from datetime import datetime, timedelta
 from ox.data import (
     DATE_FORMAT,
+    Goal,
     Movement,
     MovementDefinition,
+    MovementGroup,
     Note,
+    RestDay,
     StoredQuery,
     TrainingSession,
     TrainingSet,
@@ -32,10 +35,24 @@ def get_name(raw_entry: Node) -> str:
     return raw_entry.child_by_field_name("name").text.decode("utf-8").strip().strip('"')
 
 
+# Accepted date formats, tried in order. ISO is canonical; the month-name
+# form is tolerated with a lint warning.
+DATE_FORMATS = (DATE_FORMAT, "%b %d %Y")
+
+
+def parse_date_text(date_str: str) -> datetime.date:
+    """Parse a date string in any accepted format."""
+    for fmt in DATE_FORMATS:
+        try:
+            return datetime.strptime(date_str, fmt).date()
+        except ValueError:
+            continue
+    raise ValueError(f"Unrecognized date: {date_str!r}")
+
+
 def get_date(raw_entry: Node) -> datetime.date:
     """Extract and parse date from node."""
-    date_str = raw_entry.child_by_field_name("date").text.decode("utf-8")
-    return datetime.strptime(date_str, DATE_FORMAT).date()
+    return parse_date_text(raw_entry.child_by_field_name("date").text.decode("utf-8"))
 
 
 def get_details(raw_entry) -> dict[str, str]:
@@ -58,6 +75,22 @@ def get_note_text(node: Node) -> str:
     return node.child_by_field_name("text").text.decode("utf-8").strip('"')
 
 
+def get_comment_text(node: Node) -> str:
+    """Extract the text of a comment node, without the leading '#'."""
+    return node.text.decode("utf-8").lstrip("#").strip()
+
+
+def _merge_comment(note: str | None, raw_entry: Node) -> str | None:
+    """Fold a trailing inline comment into a movement's note, if present."""
+    comment_node = raw_entry.child_by_field_name("comment")
+    if comment_node is None:
+        return note
+    comment = get_comment_text(comment_node)
+    if not comment:
+        return note
+    return f"{note}; {comment}" if note else comment
+
+
 def weight_text_to_quantity(weight_text: str) -> Quantity:
     """Convert weight string like "24kg" to Quantity."""
     match = re.match(r"^(\d+(?:\.\d+)?)(\w+)$", weight_text)
@@ -75,14 +108,67 @@ def weight_text_to_quantity(weight_text: str) -> Quantity:
         return None
 
 
-def process_weights(weight_str: str) -> list[Quantity]:
-    """Parse weight string into list of Quantity objects.
+# A bodyweight segment, optionally with added load: BW, bw, BW+20kg
+_BW_SEGMENT = re.compile(r"^[Bb][Ww](?:\+(.+))?$")
+
+# AMRAP rep token, with achieved reps in parens when logged: AMRAP, AMRAP(12)
+_AMRAP = re.compile(r"^AMRAP(?:\((\d+)\))?$")
+
+
+def parse_rep_token(token: str) -> tuple[int, bool]:
+    """Parse one rep token into (reps, amrap).
+
+    Plain integers parse as themselves. AMRAP parses to (0, True) when
+    open-ended and (achieved, True) when logged as AMRAP(12).
+    """
+    match = _AMRAP.match(token)
+    if match:
+        return (int(match.group(1)) if match.group(1) else 0, True)
+    return (int(token), False)
 
-    Handles formats like "24kg", "24kg+32kg", "24kg/32kg/48kg".
+
+# Rest annotation value: 3m, 90s, 3min, 2m30s
+_REST = re.compile(r"^rest\s+(?:(\d+(?:\.\d+)?)m(?:in)?)?(?:(\d+(?:\.\d+)?)s)?$")
+
+
+def parse_tempo_text(text: str) -> tuple[int, int, int, int] | None:
+    """Parse a tempo annotation like 'tempo 3-1-1-0' into four seconds."""
+    match = re.match(r"^tempo\s+(\d+)-(\d+)-(\d+)-(\d+)$", text.strip())
+    if not match:
+        return None
+    return tuple(int(g) for g in match.groups())
+
+
+def parse_rest_text(text: str) -> timedelta | None:
+    """Parse a rest annotation like 'rest 2m30s' into a timedelta."""
+    match = _REST.match(text.strip())
+    if not match or (match.group(1) is None and match.group(2) is None):
+        return None
+    minutes = float(match.group(1)) if match.group(1) else 0.0
+    seconds = float(match.group(2)) if match.group(2) else 0.0
+    return timedelta(minutes=minutes, seconds=seconds)
+
+
+def process_weight_segments(
+    weight_str: str,
+    default_unit: str | None = None,
+    bodyweight: Quantity | None = None,
+) -> list[tuple[Quantity | None, bool, Quantity | None]]:
+    """Parse a weight string into per-set (weight, is_bodyweight, added) triples.
+
+    Handles formats like "24kg", "24kg+32kg", "24kg/32kg/48kg", "BW",
+    "BW+20kg".
 
     In progressive sequences, a segment may omit its unit; it inherits the
     nearest succeeding unit. E.g. "160/185/210lb" → three lb weights;
     "60/70kg/160/180lb" → [60kg, 70kg, 160lb, 180lb].
+
+    When no unit can be resolved for a segment, default_unit (from a file's
+    @units directive) is used; without one the segment parses to None.
+
+    Bodyweight segments resolve to bodyweight (plus any added load) when
+    the file declares @bodyweight; otherwise their weight is None and only
+    the added load is recorded.
     """
     weight_str_split = weight_str.split("/")
     # Right-to-left pass to resolve implied units.
@@ -90,7 +176,7 @@ def process_weights(weight_str: str) -> list[Quantity]:
     resolved = [None] * len(weight_str_split)
     for i in range(len(weight_str_split) - 1, -1, -1):
         w = weight_str_split[i]
-        if w == "BW" or "+" in w:
+        if _BW_SEGMENT.match(w) or "+" in w:
             resolved[i] = w
             continue
         m = re.match(r"^(\d+(?:\.\d+)?)(\w+)?$", w)
@@ -99,31 +185,65 @@ def process_weights(weight_str: str) -> list[Quantity]:
             continue
         num, unit = m.group(1), m.group(2)
         if unit is None:
-            if carried_unit is None:
-                resolved[i] = w  # will fail to parse downstream
-            else:
+            if carried_unit is not None:
                 resolved[i] = f"{num}{carried_unit}"
+            elif default_unit is not None:
+                resolved[i] = f"{num}{default_unit}"
+            else:
+                resolved[i] = w  # will fail to parse downstream
         else:
             carried_unit = unit
             resolved[i] = w
 
-    weight_objs = []
+    segments = []
     for w in resolved:
-        if "+" in w:
+        bw_match = _BW_SEGMENT.match(w)
+        if bw_match:
+            added = (
+                weight_text_to_quantity(bw_match.group(1))
+                if bw_match.group(1)
+                else None
+            )
+            if bodyweight is not None:
+                total = bodyweight + added if added is not None else bodyweight
+            else:
+                total = None
+            segments.append((total, True, added))
+        elif "+" in w:
             result = sum([weight_text_to_quantity(i) for i in w.split("+")])
-            weight_objs.append(result)
+            segments.append((result, False, None))
         else:
-            result = weight_text_to_quantity(w)
-            weight_objs.append(result)
+            segments.append((weight_text_to_quantity(w), False, None))
 
-    return weight_objs
+    return segments
 
 
-def process_details(details: dict[str, str]) -> tuple[list[TrainingSet], str | None]:
+def process_weights(
+    weight_str: str,
+    default_unit: str | None = None,
+    bodyweight: Quantity | None = None,
+) -> list[Quantity]:
+    """Parse weight string into list of Quantity objects.
+
+    See process_weight_segments for the formats handled; this keeps only
+    the per-set weights.
+    """
+    return [
+        w for w, _, _ in process_weight_segments(weight_str, default_unit, bodyweight)
+    ]
+
+
+def process_details(
+    details: dict[str, str],
+    default_unit: str | None = None,
+    bodyweight: Quantity | None = None,
+) -> tuple[list[TrainingSet], str | None]:
     """Parse item details into training sets and notes.
 
     Args:
         details: Dict of detail field names to values
+        default_unit: Unit for unitless weights (from an @units directive)
+        bodyweight: The lifter's bodyweight (from an @bodyweight directive)
 
     Returns:
         Tuple of (sets, note)
@@ -132,21 +252,82 @@ def process_details(details: dict[str, str]) -> tuple[list[TrainingSet], str | N
     reps = None
     note = None
     sets = []
+    rep_range = None
+    clusters = None
     if "rep_scheme" in details.keys():
         reps_raw = details["rep_scheme"]
         if "/" in reps_raw:
-            reps = [int(r) for r in details["rep_scheme"].split("/")]
+            reps = [parse_rep_token(r) for r in reps_raw.split("/")]
         elif "x" in reps_raw:
-            s, r = reps_raw.split("x")
-            reps = [int(r) for i in range(int(s))]
+            s, r = reps_raw.split("x", 1)
+            if r.startswith("(") and r.endswith(")"):
+                inner = r[1:-1]
+                if "-" in inner:
+                    # Rep range: reps holds the midpoint as an estimate
+                    lo, hi = (int(v) for v in inner.split("-", 1))
+                    rep_range = (lo, hi)
+                    reps = [((lo + hi) // 2, False) for i in range(int(s))]
+                else:
+                    # Cluster set: reps holds the per-set total
+                    clusters = tuple(int(v) for v in inner.split("+"))
+                    reps = [(sum(clusters), False) for i in range(int(s))]
+            else:
+                reps = [parse_rep_token(r) for i in range(int(s))]
 
     if "weight" in details.keys():
-        weights = process_weights(details["weight"])
+        weights = process_weight_segments(details["weight"], default_unit, bodyweight)
+
+    rpe = None
+    rir = None
+    if "effort" in details.keys():
+        effort_raw = details["effort"][1:]  # strip leading @
+        if effort_raw.upper().startswith("RIR"):
+            rir = int(effort_raw[3:])
+        else:
+            rpe = float(effort_raw)
+
+    rest = None
+    if "rest" in details.keys():
+        rest = parse_rest_text(details["rest"])
+
+    tempo = None
+    if "tempo" in details.keys():
+        tempo = parse_tempo_text(details["tempo"])
+
+    warmup = "warmup" in details.keys()
+
+    velocity = None
+    if "velocity" in details.keys():
+        velocity = float(details["velocity"][1:])  # strip leading v
+
+    tags: tuple[str, ...] = ()
+    if "tags" in details.keys():
+        tags = tuple(
+            t.strip() for t in details["tags"].strip("[]").split(",") if t.strip()
+        )
+
     if weights and reps:
         if len(weights) > 1 and len(weights) != len(reps):
             print("potentially incomplete entry, assume same weight across sets")
-        for i, r in enumerate(reps):
-            training_set = TrainingSet(reps=r, weight=get_or_last(weights, i))
+        for i, (r, amrap) in enumerate(reps):
+            weight, is_bodyweight, added = get_or_last(weights, i)
+            training_set = TrainingSet(
+                reps=r,
+                weight=weight,
+                rpe=rpe,
+                rir=rir,
+                bodyweight=is_bodyweight,
+                added_weight=added,
+                rest=rest,
+                amrap=amrap,
+                tags=tags,
+                tempo=tempo,
+                rep_min=rep_range[0] if rep_range else None,
+                rep_max=rep_range[1] if rep_range else None,
+                clusters=clusters,
+                warmup=warmup,
+                velocity=velocity,
+            )
             sets.append(training_set)
     if "note" in details.keys():
         note = re.sub(
@@ -160,6 +341,8 @@ def process_details(details: dict[str, str]) -> tuple[list[TrainingSet], str | N
 
 def process_singleline_completed_session(
     raw_entry: Node,
+    default_unit: str | None = None,
+    bodyweight: Quantity | None = None,
 ) -> tuple[datetime.date, tuple[Movement, ...]]:
     """Process a completed single-line entry.
 
@@ -169,34 +352,78 @@ def process_singleline_completed_session(
     item = get_item(raw_entry)
     date = get_date(raw_entry)
     details = get_details(raw_entry)
-    sets, note = process_details(details)
+    sets, note = process_details(details, default_unit, bodyweight)
+    note = _merge_comment(note, raw_entry)
     movement = tuple([Movement(name=item, sets=sets, note=note)])
     return date, movement
 
 
+def process_item_line(
+    node: Node,
+    default_unit: str | None = None,
+    bodyweight: Quantity | None = None,
+) -> Movement:
+    """Process an item_line node into a Movement."""
+    item = get_item(node)
+    details = get_details(node)
+    sets, note = process_details(details, default_unit, bodyweight)
+    return Movement(name=item, sets=sets, note=_merge_comment(note, node))
+
+
+def process_group_block(
+    node: Node,
+    default_unit: str | None = None,
+    bodyweight: Quantity | None = None,
+) -> MovementGroup:
+    """Process a @superset/@circuit group_block node into a MovementGroup."""
+    kind = node.children[0].type.lstrip("@")
+    movements = tuple(
+        process_item_line(c, default_unit, bodyweight)
+        for c in node.children
+        if c.type == "item_line"
+    )
+    return MovementGroup(kind=kind, movements=movements)
+
+
 def process_session_block_completed(
     raw_entry: Node,
-) -> tuple[datetime.date, str, list[Movement], tuple[Note, ...]]:
+    default_unit: str | None = None,
+    bodyweight: Quantity | None = None,
+) -> tuple[datetime.date, str, list[Movement], tuple[Note, ...], list[MovementGroup]]:
     """Process a completed session block.
 
+    Grouped movements are flattened into the movement list in performed
+    order; the groups themselves are returned alongside for round-tripping.
+
     Returns:
-        Tuple of (date, name, movements, notes)
+        Tuple of (date, name, movements, notes, groups)
     """
     movements = []
+    groups = []
+    notes = []
     date = get_date(raw_entry)
     name = get_name(raw_entry)
-    item_lines = [c for c in raw_entry.children if c.type == "item_line"]
-    for m in item_lines:
-        item = get_item(m)
-        details = get_details(m)
-        sets, note = process_details(details)
-        movements.append(Movement(name=item, sets=sets, note=note))
-    note_lines = [c for c in raw_entry.children if c.type == "note_line"]
-    notes = tuple(Note(text=get_note_text(n)) for n in note_lines)
-    return date, name, movements, notes
-
-
-def process_singleline_entry(raw_entry: Node) -> TrainingSession | None:
+    for c in raw_entry.children:
+        if c.type == "item_line":
+            movements.append(process_item_line(c, default_unit, bodyweight))
+        elif c.type == "group_block":
+            group = process_group_block(c, default_unit, bodyweight)
+            groups.append(group)
+            movements.extend(group.movements)
+        elif c.type == "note_line":
+            notes.append(Note(text=get_note_text(c)))
+        elif c.type == "comment":
+            comment = get_comment_text(c)
+            if comment:
+                notes.append(Note(text=comment))
+    return date, name, movements, tuple(notes), groups
+
+
+def process_singleline_entry(
+    raw_entry: Node,
+    default_unit: str | None = None,
+    bodyweight: Quantity | None = None,
+) -> TrainingSession | None:
     """Process a single-line entry node.
 
     Returns:
@@ -205,7 +432,9 @@ def process_singleline_entry(raw_entry: Node) -> TrainingSession | None:
     flag = get_flag(raw_entry)
 
     if flag in ["*", "!"]:
-        date, movement = process_singleline_completed_session(raw_entry)
+        date, movement = process_singleline_completed_session(
+            raw_entry, default_unit, bodyweight
+        )
         return TrainingSession(
             name=movement[0].name, date=date, flag=flag, movements=movement
         )
@@ -221,7 +450,11 @@ def process_session_block_pending(raw_entry: Node) -> TrainingSession | None:
     return None
 
 
-def process_session_block(raw_entry: Node) -> TrainingSession | None:
+def process_session_block(
+    raw_entry: Node,
+    default_unit: str | None = None,
+    bodyweight: Quantity | None = None,
+) -> TrainingSession | None:
     """Process a session block node.
 
     Returns:
@@ -230,9 +463,16 @@ def process_session_block(raw_entry: Node) -> TrainingSession | None:
     flag = get_flag(raw_entry)
 
     if flag in ["*", "!"]:
-        date, name, movements, notes = process_session_block_completed(raw_entry)
+        date, name, movements, notes, groups = process_session_block_completed(
+            raw_entry, default_unit, bodyweight
+        )
         return TrainingSession(
-            name=name, flag=flag, date=date, movements=tuple(movements), notes=notes
+            name=name,
+            flag=flag,
+            date=date,
+            movements=tuple(movements),
+            notes=notes,
+            groups=tuple(groups),
         )
     else:
         return process_session_block_pending(raw_entry)
@@ -259,6 +499,13 @@ def process_weigh_in_entry(node: Node) -> WeighIn:
     return WeighIn(date=entry_date, weight=weight, time_of_day=time_of_day, scale=scale)
 
 
+def process_rest_entry(node: Node) -> RestDay:
+    """Process a rest_entry node into a RestDay."""
+    note_node = node.child_by_field_name("note")
+    note = note_node.text.decode("utf-8").strip('"') if note_node else None
+    return RestDay(date=get_date(node), note=note)
+
+
 def process_query_entry(node: Node) -> StoredQuery:
     """Process a query_entry node."""
     date = get_date(node)
@@ -308,25 +555,51 @@ def process_plugin_directive(node: Node) -> str:
     return raw.strip('"')
 
 
-def process_node(node: Node) -> TrainingSession | Note | StoredQuery | None:
+def process_units_directive(node: Node) -> str:
+    """Extract the default unit from a units_directive node."""
+    return node.child_by_field_name("unit").text.decode("utf-8")
+
+
+def process_bodyweight_directive(node: Node) -> Quantity | None:
+    """Extract the lifter's bodyweight from a bodyweight_directive node."""
+    weight_text = node.child_by_field_name("weight").text.decode("utf-8")
+    return weight_text_to_quantity(weight_text)
+
+
+def process_goal_directive(node: Node) -> Goal:
+    """Process a goal_directive node into a Goal."""
+    movement = node.child_by_field_name("movement").text.decode("utf-8")
+    weight_text = node.child_by_field_name("weight").text.decode("utf-8")
+    return Goal(movement=movement, target=weight_text_to_quantity(weight_text))
+
+
+def process_node(
+    node: Node,
+    default_unit: str | None = None,
+    bodyweight: Quantity | None = None,
+) -> TrainingSession | Note | StoredQuery | None:
     """Process any node type and return appropriate data structure.
 
     Args:
         node: Tree-sitter node to process
+        default_unit: Unit for unitless weights (from an @units directive)
+        bodyweight: The lifter's bodyweight (from an @bodyweight directive)
 
     Returns:
         TrainingSession, Note, StoredQuery, or None
     """
     if node.type == "singleline_entry":
-        return process_singleline_entry(node)
+        return process_singleline_entry(node, default_unit, bodyweight)
     if node.type == "session_block":
-        return process_session_block(node)
+        return process_session_block(node, default_unit, bodyweight)
     if node.type == "note_entry":
         return process_note_entry(node)
     if node.type == "query_entry":
         return process_query_entry(node)
     if node.type == "weigh_in_entry":
         return process_weigh_in_entry(node)
+    if node.type == "rest_entry":
+        return process_rest_entry(node)
     if node.type == "movement_block":
         return process_movement_block(node)
     # Skip comments, template_block for now
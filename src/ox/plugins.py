@@ -5,8 +5,14 @@ a list of plugin descriptors (dicts). Each plugin receives a PluginContext
 and returns a TableResult, TextResult, or PlotResult.
 
 Discovery sources (loaded in order):
-1. Built-in plugins (volume, e1rm, weighin, wendler531, srpe)
+1. Built-in plugins (volume, e1rm, weighin, wendler531, srpe, progression)
 2. @plugin directives in .ox files
+
+Library code can also use the registry directly: register_plugin() adds a
+single descriptor without a .ox directive, run_plugin() invokes one by
+name with keyword arguments, and list_plugins() enumerates what is
+registered. The built-in calculators (e1rm, plates, ...) arrive through
+the same registry, so user additions are indistinguishable from them.
 """
 
 import importlib.util
@@ -34,6 +40,7 @@ class PluginContext:
 class TableResult:
     columns: list[str]
     rows: list[tuple]
+    note: str | None = None  # caveat printed under the table
 
 
 @dataclass(frozen=True, slots=True)
@@ -98,12 +105,90 @@ def _load_from_log_directives(log: TrainingLog, base_path: Path) -> None:
 
 def _load_builtins() -> None:
     """Load plugins that ship with ox."""
-    from ox.builtins import e1rm, srpe, volume, weighin, wendler531
-
-    for mod in (volume, e1rm, weighin, wendler531, srpe):
+    from ox.builtins import (
+        chart,
+        compare,
+        consistency,
+        deload,
+        e1rm,
+        goals,
+        plateau,
+        plates,
+        progression,
+        srpe,
+        volume,
+        weighin,
+        wendler531,
+    )
+
+    for mod in (
+        volume,
+        e1rm,
+        weighin,
+        wendler531,
+        srpe,
+        progression,
+        plates,
+        plateau,
+        chart,
+        deload,
+        consistency,
+        compare,
+        goals,
+    ):
         _register_descriptors(mod.register(), f"builtin:{mod.__name__}")
 
 
+def register_plugin(
+    descriptor: dict, source: str = "api", is_user: bool = True
+) -> None:
+    """Register a single plugin descriptor programmatically.
+
+    The descriptor uses the same shape as register() return values:
+    {"name", "fn", "description", "params"}. Redefining an existing
+    name logs a warning and replaces it, matching directive loading.
+
+    Raises:
+        ValueError: If the descriptor is missing "name" or "fn".
+    """
+    if not descriptor.get("name") or "fn" not in descriptor:
+        raise ValueError(f"Malformed plugin descriptor: {descriptor}")
+    _register_descriptors([descriptor], source, is_user=is_user)
+
+
+def run_plugin(name: str, ctx: PluginContext, **kwargs):
+    """Invoke a registered plugin by name with keyword arguments.
+
+    Arguments are validated against the plugin's declared params;
+    omitted optional params fall back to the fn's own defaults.
+
+    Returns:
+        The plugin's result (TableResult, TextResult, or PlotResult).
+
+    Raises:
+        KeyError: If no plugin with that name is registered.
+        ValueError: If an argument is unknown or a required one is missing.
+    """
+    if name not in PLUGINS:
+        raise KeyError(f"Unknown plugin: {name}")
+    entry = PLUGINS[name]
+    params = {p["name"]: p for p in entry.get("params", [])}
+    for key in kwargs:
+        if key not in params:
+            raise ValueError(f"Unknown argument {key!r} for plugin '{name}'")
+    for pname, param in params.items():
+        if param.get("required") and pname not in kwargs:
+            raise ValueError(f"Missing required argument {pname!r} for plugin '{name}'")
+    return entry["fn"](ctx, **kwargs)
+
+
+def list_plugins() -> list[tuple[str, str]]:
+    """Registered plugins as sorted (name, description) pairs."""
+    return sorted(
+        (name, entry.get("description", "")) for name, entry in PLUGINS.items()
+    )
+
+
 def load_plugins(log: TrainingLog | None = None, base_path: Path | None = None) -> None:
     """Discover and load all plugins. Call once at startup."""
     PLUGINS.clear()
@@ -0,0 +1,89 @@
+"""Expand @template blocks into concrete planned sessions.
+
+Templates store percentage-based loads against a per-movement training
+max ("bench-press: 85% 5x1"). Expansion substitutes the percentages,
+rounds to plate increments, and yields planned sessions — one per
+template block, a week apart — that serialize back to concrete ox text.
+"""
+
+from datetime import date, timedelta
+from pathlib import Path
+
+from tree_sitter import Language, Parser
+import tree_sitter_ox
+
+from ox.data import Movement, TrainingLog, TrainingSession
+from ox.parse import get_details, get_item, process_details
+from ox.rounding import Rounding
+
+
+def _expand_item(
+    node, maxes: dict[str, float], rounding: Rounding, unit: str
+) -> Movement:
+    """Expand one template item_line, substituting any percentage load."""
+    name = get_item(node)
+    details = get_details(node)
+    if "percent" in details:
+        if name not in maxes:
+            raise ValueError(f"No training max for '{name}'")
+        pct = float(details.pop("percent").rstrip("%"))
+        load = rounding.round(maxes[name] * pct / 100)
+        details["weight"] = f"{load:g}{unit}"
+    sets, note = process_details(details)
+    return Movement(name=name, sets=sets, note=note)
+
+
+def expand(
+    template_path: Path,
+    maxes: dict[str, float],
+    plate: float = 2.5,
+    unit: str = "kg",
+    start: date | None = None,
+    mode: str = "down",
+) -> TrainingLog:
+    """Expand a template file into a log of planned sessions.
+
+    Args:
+        template_path: File containing @template blocks
+        maxes: Training max per movement, in `unit`
+        plate: Increment to round substituted loads to
+        unit: Unit of the training maxes ("kg" or "lb")
+        start: Date of the first session (default today); each subsequent
+            template block lands a week later
+        mode: Rounding direction ("nearest", "down", or "up"); down by
+            default so every programmed load is actually loadable
+
+    Returns:
+        TrainingLog of planned (!) sessions, one per template block
+
+    Raises:
+        ValueError: If a percentage load has no training max to resolve
+            against
+    """
+    language = Language(tree_sitter_ox.language())
+    parser = Parser(language)
+    tree = parser.parse(Path(template_path).read_bytes())
+
+    if start is None:
+        start = date.today()
+    rounding = Rounding(increment=plate, mode=mode)
+
+    sessions = []
+    for node in tree.root_node.children:
+        if node.type != "template_block":
+            continue
+        name = node.child_by_field_name("name").text.decode("utf-8").strip().strip('"')
+        movements = tuple(
+            _expand_item(c, maxes, rounding, unit)
+            for c in node.children
+            if c.type == "item_line"
+        )
+        sessions.append(
+            TrainingSession(
+                date=start + timedelta(weeks=len(sessions)),
+                flag="!",
+                name=name,
+                movements=movements,
+            )
+        )
+    return TrainingLog(sessions=tuple(sessions))
@@ -0,0 +1,199 @@
+"""Markdown report export for training logs.
+
+Renders a shareable summary of a date range — totals, weekly tonnage,
+PR highlights, and per-movement best lifts — the kind of thing pasted
+into a coaching check-in. Output is deterministic (sorted movements,
+fixed rounding) so successive exports diff cleanly. Warmup-marked sets
+are excluded throughout, matching the working-set analyses.
+"""
+
+from dataclasses import dataclass, replace
+from datetime import date, timedelta
+from typing import Optional, TextIO
+
+from ox.builtins.e1rm import one_rep_max
+from ox.data import TrainingLog, TrainingSet, _format_weight
+
+
+@dataclass(frozen=True, slots=True)
+class MarkdownOptions:
+    """Section toggles for to_markdown. The header totals always render.
+
+    Attributes:
+        weekly_tonnage: Include the per-week tonnage table
+        prs: Include the PR highlights section
+        best_lifts: Include the per-movement best-lift table
+    """
+
+    weekly_tonnage: bool = True
+    prs: bool = True
+    best_lifts: bool = True
+
+
+def _human_date(d: date) -> str:
+    """Format a date for headings: 'Jan 6, 2025'."""
+    return f"{d.strftime('%b')} {d.day}, {d.year}"
+
+
+def _fmt_kg(kg: float) -> str:
+    """Format kilograms with a thousands separator, one decimal at most."""
+    rounded = round(kg, 1)
+    if rounded == int(rounded):
+        return f"{int(rounded):,}"
+    return f"{rounded:,}"
+
+
+def _set_str(s: TrainingSet) -> str:
+    """Describe a set as 'weight × reps' in its original unit."""
+    weight = _format_weight(s.weight) if s.weight is not None else "BW"
+    return f"{weight} × {s.reps}"
+
+
+def _week_of(d: date) -> date:
+    """Monday of the ISO week containing d."""
+    return d - timedelta(days=d.weekday())
+
+
+def to_markdown(
+    log: TrainingLog,
+    start: Optional[date] = None,
+    end: Optional[date] = None,
+    options: Optional[MarkdownOptions] = None,
+) -> str:
+    """Render a Markdown summary of the log over an inclusive date range.
+
+    Args:
+        log: Training log
+        start: Earliest session date (None for the log's first session)
+        end: Latest session date (None for the log's last session)
+        options: Section toggles; defaults to all sections on
+
+    Returns:
+        Markdown text ending in a single trailing newline
+    """
+    if options is None:
+        options = MarkdownOptions()
+
+    sessions = log.filter(start=start, end=end)
+    sessions = tuple(s for s in sessions if s.flag == "*")
+    if sessions:
+        start = start or sessions[0].date
+        end = end or sessions[-1].date
+
+    if start and end:
+        heading = f"# Training report — {_human_date(start)} – {_human_date(end)}"
+    else:
+        heading = "# Training report"
+    lines = [heading, ""]
+
+    if not sessions:
+        lines.append("No completed sessions in this period.")
+        return "\n".join(lines) + "\n"
+
+    sets = reps = 0
+    tonnage = 0.0
+    for session in sessions:
+        for m in session.movements:
+            for s in m.sets:
+                if s.warmup:
+                    continue
+                sets += 1
+                reps += s.reps
+                if s.weight_kg is not None:
+                    tonnage += s.weight_kg * s.reps
+    lines += [
+        f"- Sessions: {len(sessions)}",
+        f"- Working sets: {sets}",
+        f"- Reps: {reps}",
+        f"- Tonnage: {_fmt_kg(tonnage)} kg",
+    ]
+
+    if options.weekly_tonnage:
+        weekly: dict[date, list[float]] = {}
+        for session in sessions:
+            week = weekly.setdefault(_week_of(session.date), [0, 0.0])
+            week[0] += 1
+            week[1] += sum(
+                s.weight_kg * s.reps
+                for m in session.movements
+                for s in m.sets
+                if s.weight_kg is not None and not s.warmup
+            )
+        lines += [
+            "",
+            "## Weekly tonnage",
+            "",
+            "| Week of | Sessions | Tonnage (kg) |",
+            "| --- | ---: | ---: |",
+        ]
+        for week in sorted(weekly):
+            count, kg = weekly[week]
+            lines.append(f"| {_human_date(week)} | {count} | {_fmt_kg(kg)} |")
+
+    records = replace(log, sessions=sessions).personal_records()
+
+    if options.prs:
+        lines += ["", "## PR highlights", ""]
+        all_time = log.personal_records()
+        highlights = []
+        for name in sorted(records):
+            rec = all_time.get(name)
+            if (
+                rec is None
+                or rec.max_weight_date is None
+                or not (start <= rec.max_weight_date <= end)
+            ):
+                continue
+            e1rm = one_rep_max(
+                float(rec.max_weight.to("kilogram").magnitude),
+                rec.max_weight_set.reps,
+            )
+            highlights.append(
+                f"- **{name}** — {_set_str(rec.max_weight_set)} "
+                f"(e1RM {_fmt_kg(e1rm)} kg) on {_human_date(rec.max_weight_date)}"
+            )
+        lines += highlights or ["No new records in this period."]
+
+    if options.best_lifts:
+        lines += [
+            "",
+            "## Best lifts",
+            "",
+            "| Movement | Best set | e1RM (kg) | Date |",
+            "| --- | --- | ---: | --- |",
+        ]
+        for name in sorted(records):
+            rec = records[name]
+            if rec.max_weight_set is None:
+                best = f"BW × {rec.max_reps}" if rec.max_reps else "—"
+                lines.append(f"| {name} | {best} | — | — |")
+                continue
+            e1rm = one_rep_max(
+                float(rec.max_weight.to("kilogram").magnitude),
+                rec.max_weight_set.reps,
+            )
+            lines.append(
+                f"| {name} | {_set_str(rec.max_weight_set)} | {_fmt_kg(e1rm)} "
+                f"| {_human_date(rec.max_weight_date)} |"
+            )
+
+    return "\n".join(lines) + "\n"
+
+
+def write_markdown(
+    log: TrainingLog,
+    out: TextIO,
+    start: Optional[date] = None,
+    end: Optional[date] = None,
+    options: Optional[MarkdownOptions] = None,
+) -> None:
+    """Write to_markdown output to a file-like object.
+
+    Args:
+        log: Training log
+        out: Writable text stream
+        start: Earliest session date (None for the log's first session)
+        end: Latest session date (None for the log's last session)
+        options: Section toggles; defaults to all sections on
+    """
+    out.write(to_markdown(log, start=start, end=end, options=options))
@@ -0,0 +1,48 @@
+"""Plate-rounding strategies shared by the load calculators.
+
+Different gyms have different smallest plates (2.5kg pairs, 1.25kg
+micros, 5lb), and a programmed load is only useful if it can actually be
+put on the bar. A Rounding pairs an increment with a direction; "down" is
+the safe default since rounding up can program a weight the gym can't
+load.
+"""
+
+import math
+from dataclasses import dataclass
+
+MODES = ("nearest", "down", "up")
+
+# Guard against float noise like 87.49999999 refusing to round to 87.5.
+_EPSILON = 1e-9
+
+
+@dataclass(frozen=True, slots=True)
+class Rounding:
+    """A plate-rounding strategy.
+
+    Attributes:
+        increment: Smallest loadable step (e.g. 2.5 for kg plates)
+        mode: Direction: "nearest", "down", or "up"
+    """
+
+    increment: float = 2.5
+    mode: str = "down"
+
+    def __post_init__(self):
+        if self.increment <= 0:
+            raise ValueError(f"Rounding increment must be positive: {self.increment}")
+        if self.mode not in MODES:
+            raise ValueError(
+                f"Unknown rounding mode {self.mode!r}; expected one of {', '.join(MODES)}"
+            )
+
+    def round(self, weight: float) -> float:
+        """Snap a weight to the increment in this strategy's direction."""
+        steps = weight / self.increment
+        if self.mode == "down":
+            steps = math.floor(steps + _EPSILON)
+        elif self.mode == "up":
+            steps = math.ceil(steps - _EPSILON)
+        else:
+            steps = round(steps)
+        return steps * self.increment
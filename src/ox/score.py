@@ -0,0 +1,99 @@
+"""Bodyweight-adjusted strength scores (DOTS, Wilks).
+
+Pure functions independent of the log, so they are usable standalone:
+
+    >>> from ox.score import dots, Sex
+    >>> dots(600, 100, Sex.MALE)
+    369.3...
+
+Both formulas multiply the total by 500 over a per-sex polynomial in
+bodyweight, using the published coefficients. Bodyweights outside the
+polynomial's supported range raise ValueError rather than extrapolating.
+"""
+
+from enum import Enum
+
+
+class Sex(Enum):
+    MALE = "male"
+    FEMALE = "female"
+
+
+# Polynomial coefficients in ascending order (constant term first).
+_DOTS_COEFFS = {
+    Sex.MALE: (-307.75076, 24.0900756, -0.1918759221, 0.0007391293, -0.000001093),
+    Sex.FEMALE: (-57.96288, 13.6175032, -0.1126655495, 0.0005158568, -0.0000010706),
+}
+
+_WILKS_COEFFS = {
+    Sex.MALE: (
+        -216.0475144,
+        16.2606339,
+        -0.002388645,
+        -0.00113732,
+        7.01863e-06,
+        -1.291e-08,
+    ),
+    Sex.FEMALE: (
+        594.31747775582,
+        -27.23842536447,
+        0.82112226871,
+        -0.00930733913,
+        4.731582e-05,
+        -9.054e-08,
+    ),
+}
+
+# Bodyweight ranges (kg) the published coefficients cover.
+_DOTS_RANGE = {Sex.MALE: (40.0, 210.0), Sex.FEMALE: (40.0, 150.0)}
+_WILKS_RANGE = {Sex.MALE: (40.0, 201.9), Sex.FEMALE: (26.51, 154.53)}
+
+
+def _coefficient(bodyweight_kg: float, coeffs: tuple[float, ...]) -> float:
+    denominator = sum(c * bodyweight_kg**i for i, c in enumerate(coeffs))
+    return 500.0 / denominator
+
+
+def _check_range(bodyweight_kg: float, bounds: tuple[float, float], sex: Sex) -> None:
+    low, high = bounds
+    if not low <= bodyweight_kg <= high:
+        raise ValueError(
+            f"Bodyweight {bodyweight_kg:g}kg outside supported range "
+            f"{low:g}-{high:g}kg for {sex.value}"
+        )
+
+
+def dots(total_kg: float, bodyweight_kg: float, sex: Sex) -> float:
+    """DOTS score for a total lifted at a given bodyweight.
+
+    Args:
+        total_kg: Total lifted, in kilograms
+        bodyweight_kg: Lifter's bodyweight, in kilograms
+        sex: Coefficient set to use
+
+    Returns:
+        DOTS points
+
+    Raises:
+        ValueError: If bodyweight is outside the supported range
+    """
+    _check_range(bodyweight_kg, _DOTS_RANGE[sex], sex)
+    return total_kg * _coefficient(bodyweight_kg, _DOTS_COEFFS[sex])
+
+
+def wilks(total_kg: float, bodyweight_kg: float, sex: Sex) -> float:
+    """Wilks score for a total lifted at a given bodyweight.
+
+    Args:
+        total_kg: Total lifted, in kilograms
+        bodyweight_kg: Lifter's bodyweight, in kilograms
+        sex: Coefficient set to use
+
+    Returns:
+        Wilks points
+
+    Raises:
+        ValueError: If bodyweight is outside the supported range
+    """
+    _check_range(bodyweight_kg, _WILKS_RANGE[sex], sex)
+    return total_kg * _coefficient(bodyweight_kg, _WILKS_COEFFS[sex])
@@ -0,0 +1,305 @@
+"""JSON serialization for training logs.
+
+Emits a stable, documented schema for feeding dashboards and for
+persisting a parsed log so it can be reloaded without re-parsing the DSL:
+
+    {
+      "version": 1,
+      "default_unit": "lb",                 # omitted when absent
+      "bodyweight": {"magnitude": 82.0, "unit": "kilogram"},
+      "sessions": [
+        {
+          "date": "2025-01-10",             # RFC 3339 date-only
+          "flag": "*",
+          "name": "Upper Day",
+          "movements": [
+            {
+              "name": "bench-press",
+              "note": "...",                # omitted when absent
+              "sets": [
+                {
+                  "reps": 5,
+                  "weight": {"magnitude": 135.0, "unit": "pound"},
+                  "rpe": 8.0,               # optional fields omitted
+                  "rir": 2,                 # when empty
+                  "bodyweight": true,
+                  "added_weight": {...},
+                  "rest": 150.0,            # seconds
+                  "amrap": true,
+                  "tags": ["paused"]
+                }
+              ]
+            }
+          ],
+          "notes": ["felt strong"],
+          "groups": [{"kind": "superset", "movements": [1, 2]}]
+        }
+      ],
+      "notes": [{"date": "2025-01-10", "text": "deload week"}],
+      "weigh_ins": [{"date": ..., "weight": ..., "time": "06:30", "scale": "home"}],
+      "rest_days": [{"date": "2025-01-12", "note": "planned off day"}],
+      "goals": [{"movement": "squat", "target": {"magnitude": 180.0,
+                 "unit": "kilogram"}}],
+      "queries": [{"name": ..., "sql": ..., "date": ...}],
+      "movement_definitions": [{"name": ..., "equipment": ..., "tags": [...],
+                                "note": ..., "url": ...}]
+    }
+
+Group movements are indices into the session's movement list. Diagnostics
+are parse artifacts and are not serialized.
+"""
+
+import json
+from datetime import date, time, timedelta
+
+from pint import Quantity
+
+from ox.data import (
+    Goal,
+    Movement,
+    MovementDefinition,
+    MovementGroup,
+    Note,
+    RestDay,
+    StoredQuery,
+    TrainingLog,
+    TrainingSession,
+    TrainingSet,
+    WeighIn,
+)
+from ox.units import Q_
+
+SCHEMA_VERSION = 1
+
+
+def _weight_dict(q: Quantity) -> dict:
+    return {"magnitude": float(q.magnitude), "unit": str(q.units)}
+
+
+def _weight_from(d: dict) -> Quantity:
+    return Q_(d["magnitude"], d["unit"])
+
+
+def _set_dict(s: TrainingSet) -> dict:
+    out: dict = {"reps": s.reps}
+    if s.weight is not None:
+        out["weight"] = _weight_dict(s.weight)
+    if s.rpe is not None:
+        out["rpe"] = s.rpe
+    if s.rir is not None:
+        out["rir"] = s.rir
+    if s.bodyweight:
+        out["bodyweight"] = True
+    if s.added_weight is not None:
+        out["added_weight"] = _weight_dict(s.added_weight)
+    if s.rest is not None:
+        out["rest"] = s.rest.total_seconds()
+    if s.amrap:
+        out["amrap"] = True
+    if s.tags:
+        out["tags"] = list(s.tags)
+    if s.tempo is not None:
+        out["tempo"] = list(s.tempo)
+    if s.rep_min is not None:
+        out["rep_min"] = s.rep_min
+        out["rep_max"] = s.rep_max
+    if s.clusters is not None:
+        out["clusters"] = list(s.clusters)
+    if s.warmup:
+        out["warmup"] = True
+    if s.velocity is not None:
+        out["velocity"] = s.velocity
+    return out
+
+
+def _set_from(d: dict) -> TrainingSet:
+    return TrainingSet(
+        reps=d["reps"],
+        weight=_weight_from(d["weight"]) if "weight" in d else None,
+        rpe=d.get("rpe"),
+        rir=d.get("rir"),
+        bodyweight=d.get("bodyweight", False),
+        added_weight=_weight_from(d["added_weight"]) if "added_weight" in d else None,
+        rest=timedelta(seconds=d["rest"]) if "rest" in d else None,
+        amrap=d.get("amrap", False),
+        tags=tuple(d.get("tags", ())),
+        tempo=tuple(d["tempo"]) if "tempo" in d else None,
+        rep_min=d.get("rep_min"),
+        rep_max=d.get("rep_max"),
+        clusters=tuple(d["clusters"]) if "clusters" in d else None,
+        warmup=d.get("warmup", False),
+        velocity=d.get("velocity"),
+    )
+
+
+def _movement_dict(m: Movement) -> dict:
+    out: dict = {"name": m.name, "sets": [_set_dict(s) for s in m.sets]}
+    if m.note:
+        out["note"] = m.note
+    return out
+
+
+def _movement_from(d: dict) -> Movement:
+    return Movement(
+        name=d["name"],
+        sets=[_set_from(s) for s in d["sets"]],
+        note=d.get("note"),
+    )
+
+
+def _session_dict(session: TrainingSession) -> dict:
+    out: dict = {
+        "date": session.date.isoformat(),
+        "flag": session.flag,
+        "name": session.name,
+        "movements": [_movement_dict(m) for m in session.movements],
+    }
+    if session.notes:
+        out["notes"] = [n.text for n in session.notes]
+    if session.groups:
+        index_of = {id(m): i for i, m in enumerate(session.movements)}
+        out["groups"] = [
+            {"kind": g.kind, "movements": [index_of[id(m)] for m in g.movements]}
+            for g in session.groups
+        ]
+    return out
+
+
+def _session_from(d: dict) -> TrainingSession:
+    movements = tuple(_movement_from(m) for m in d["movements"])
+    groups = tuple(
+        MovementGroup(
+            kind=g["kind"],
+            movements=tuple(movements[i] for i in g["movements"]),
+        )
+        for g in d.get("groups", [])
+    )
+    return TrainingSession(
+        date=date.fromisoformat(d["date"]),
+        flag=d["flag"],
+        name=d["name"],
+        movements=movements,
+        notes=tuple(Note(text=t) for t in d.get("notes", [])),
+        groups=groups,
+    )
+
+
+def to_json(log: TrainingLog, indent: int | None = None) -> str:
+    """Serialize a TrainingLog to a JSON string.
+
+    Args:
+        log: The log to serialize
+        indent: Indentation passed to json.dumps (None for compact)
+
+    Returns:
+        JSON text in the schema documented in this module
+    """
+    out: dict = {
+        "version": SCHEMA_VERSION,
+        "sessions": [_session_dict(s) for s in log.sessions],
+    }
+    if log.default_unit is not None:
+        out["default_unit"] = log.default_unit
+    if log.bodyweight is not None:
+        out["bodyweight"] = _weight_dict(log.bodyweight)
+    if log.notes:
+        out["notes"] = [{"date": n.date.isoformat(), "text": n.text} for n in log.notes]
+    if log.weigh_ins:
+        out["weigh_ins"] = [
+            {
+                "date": w.date.isoformat(),
+                "weight": _weight_dict(w.weight),
+                **(
+                    {"time": w.time_of_day.isoformat("minutes")}
+                    if w.time_of_day
+                    else {}
+                ),
+                **({"scale": w.scale} if w.scale else {}),
+            }
+            for w in log.weigh_ins
+        ]
+    if log.rest_days:
+        out["rest_days"] = [
+            {
+                "date": r.date.isoformat(),
+                **({"note": r.note} if r.note else {}),
+            }
+            for r in log.rest_days
+        ]
+    if log.goals:
+        out["goals"] = [
+            {"movement": g.movement, "target": _weight_dict(g.target)}
+            for g in log.goals
+        ]
+    if log.queries:
+        out["queries"] = [
+            {"name": q.name, "sql": q.sql, "date": q.date.isoformat()}
+            for q in log.queries
+        ]
+    if log.movement_definitions:
+        out["movement_definitions"] = [
+            {
+                "name": m.name,
+                **({"equipment": m.equipment} if m.equipment else {}),
+                **({"tags": list(m.tags)} if m.tags else {}),
+                **({"note": m.note} if m.note else {}),
+                **({"url": m.url} if m.url else {}),
+            }
+            for m in log.movement_definitions
+        ]
+    return json.dumps(out, indent=indent)
+
+
+def from_json(text: str) -> TrainingLog:
+    """Reconstruct a TrainingLog from to_json output.
+
+    Args:
+        text: JSON text produced by to_json
+
+    Returns:
+        An equivalent TrainingLog (diagnostics and plugin paths are not
+        round-tripped)
+    """
+    data = json.loads(text)
+    return TrainingLog(
+        sessions=tuple(_session_from(s) for s in data["sessions"]),
+        notes=tuple(
+            Note(text=n["text"], date=date.fromisoformat(n["date"]))
+            for n in data.get("notes", [])
+        ),
+        queries=tuple(
+            StoredQuery(
+                name=q["name"], sql=q["sql"], date=date.fromisoformat(q["date"])
+            )
+            for q in data.get("queries", [])
+        ),
+        weigh_ins=tuple(
+            WeighIn(
+                date=date.fromisoformat(w["date"]),
+                weight=_weight_from(w["weight"]),
+                time_of_day=time.fromisoformat(w["time"]) if "time" in w else None,
+                scale=w.get("scale"),
+            )
+            for w in data.get("weigh_ins", [])
+        ),
+        movement_definitions=tuple(
+            MovementDefinition(
+                name=m["name"],
+                equipment=m.get("equipment"),
+                tags=tuple(m.get("tags", [])),
+                note=m.get("note"),
+                url=m.get("url"),
+            )
+            for m in data.get("movement_definitions", [])
+        ),
+        default_unit=data.get("default_unit"),
+        bodyweight=_weight_from(data["bodyweight"]) if "bodyweight" in data else None,
+        rest_days=tuple(
+            RestDay(date=date.fromisoformat(r["date"]), note=r.get("note"))
+            for r in data.get("rest_days", [])
+        ),
+        goals=tuple(
+            Goal(movement=g["movement"], target=_weight_from(g["target"]))
+            for g in data.get("goals", [])
+        ),
+    )
@@ -0,0 +1,96 @@
+"""Strength-standard classification for the big barbell lifts.
+
+Bundles bodyweight-ratio standards (in the spirit of commonly published
+strength-standard tables) and classifies a 1RM against them:
+untrained / novice / intermediate / advanced / elite.
+
+Ratios are 1RM divided by bodyweight. Age is folded in with a simple
+masters adjustment — credit of about 1% per year past 40 — which
+approximates published age coefficients without bundling the full
+tables.
+"""
+
+LEVELS = ("untrained", "novice", "intermediate", "advanced", "elite")
+
+SEXES = ("male", "female")
+
+# Bodyweight-ratio thresholds for (novice, intermediate, advanced, elite).
+# A ratio below the first threshold classifies as untrained.
+_STANDARDS: dict[str, dict[str, tuple[float, float, float, float]]] = {
+    "squat": {
+        "male": (0.75, 1.25, 1.75, 2.25),
+        "female": (0.5, 1.0, 1.4, 1.75),
+    },
+    "bench-press": {
+        "male": (0.5, 1.0, 1.5, 2.0),
+        "female": (0.35, 0.7, 1.0, 1.3),
+    },
+    "deadlift": {
+        "male": (1.0, 1.5, 2.0, 2.5),
+        "female": (0.7, 1.2, 1.6, 2.0),
+    },
+    "overhead-press": {
+        "male": (0.35, 0.55, 0.8, 1.05),
+        "female": (0.25, 0.45, 0.6, 0.75),
+    },
+    "barbell-row": {
+        "male": (0.5, 0.9, 1.2, 1.5),
+        "female": (0.4, 0.65, 0.9, 1.1),
+    },
+}
+
+
+def supported_movements() -> tuple[str, ...]:
+    """Movement names that have a bundled standard table, sorted."""
+    return tuple(sorted(_STANDARDS))
+
+
+def _age_factor(age: int) -> float:
+    """Masters adjustment: ~1% credit per year past 40, none before."""
+    if age <= 40:
+        return 1.0
+    return 1.0 + 0.01 * (age - 40)
+
+
+def level(
+    movement: str,
+    one_rm_kg: float,
+    bodyweight_kg: float,
+    sex: str,
+    age: int = 25,
+) -> tuple[str, float]:
+    """Classify a 1RM against bundled strength standards.
+
+    Args:
+        movement: Movement name (hyphenated lowercase, e.g. "bench-press")
+        one_rm_kg: One-rep max in kilograms
+        bodyweight_kg: The lifter's bodyweight in kilograms
+        sex: "male" or "female"
+        age: The lifter's age in years; lifters past 40 get a masters
+            credit on the ratio
+
+    Returns:
+        Tuple of (level, adjusted ratio) where level is one of LEVELS and
+        the ratio is 1RM / bodyweight after the age adjustment.
+
+    Raises:
+        ValueError: If the movement has no standard table, the sex is
+            unknown, or the bodyweight is not positive.
+    """
+    if movement not in _STANDARDS:
+        raise ValueError(
+            f"No strength standard table for '{movement}'. "
+            f"Supported: {', '.join(supported_movements())}"
+        )
+    if sex not in SEXES:
+        raise ValueError(f"Unknown sex: {sex!r}. Expected one of {', '.join(SEXES)}")
+    if bodyweight_kg <= 0:
+        raise ValueError("Bodyweight must be positive")
+
+    ratio = one_rm_kg / bodyweight_kg * _age_factor(age)
+    thresholds = _STANDARDS[movement][sex]
+    classification = LEVELS[0]
+    for name, threshold in zip(LEVELS[1:], thresholds):
+        if ratio >= threshold:
+            classification = name
+    return classification, round(ratio, 2)
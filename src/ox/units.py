@@ -2,3 +2,38 @@ from pint import UnitRegistry
 
 ureg = UnitRegistry()
 Q_ = ureg.Quantity
+
+# Tolerance for comparing canonical-kg weights. lb<->kg round trips leave
+# float noise (100 lb -> 45.359237 kg -> 100.0000001 lb) that must never
+# count as a different weight, let alone a new record.
+KG_EPSILON = 0.01
+
+
+def weights_equal(a_kg: float, b_kg: float, epsilon: float = KG_EPSILON) -> bool:
+    """Whether two canonical-kg weights are equal within epsilon.
+
+    Args:
+        a_kg: First weight in kilograms
+        b_kg: Second weight in kilograms
+        epsilon: Largest difference still considered equal
+
+    Returns:
+        True when the weights differ by at most epsilon
+    """
+    return abs(a_kg - b_kg) <= epsilon
+
+
+def compare_weights(a_kg: float, b_kg: float, epsilon: float = KG_EPSILON) -> int:
+    """Three-way comparison of canonical-kg weights within epsilon.
+
+    Args:
+        a_kg: First weight in kilograms
+        b_kg: Second weight in kilograms
+        epsilon: Largest difference still considered equal
+
+    Returns:
+        0 when equal within epsilon, -1 when a is lighter, 1 when heavier
+    """
+    if weights_equal(a_kg, b_kg, epsilon):
+        return 0
+    return -1 if a_kg < b_kg else 1
@@ -0,0 +1,75 @@
+"""Re-parse a log file whenever it changes on disk.
+
+Built on stdlib mtime polling rather than a platform file watcher, which
+keeps ox dependency-free and is plenty responsive for a file saved by
+hand mid-workout. Editors often write a file twice in quick succession,
+so a change is only reported once its mtime has settled.
+"""
+
+from pathlib import Path
+from threading import Event
+from typing import Callable, Optional
+
+from ox.data import TrainingLog
+
+
+def _signature(path: Path) -> Optional[tuple[int, int]]:
+    """(mtime_ns, size) for a file, or None while it doesn't exist."""
+    try:
+        st = path.stat()
+    except OSError:
+        return None
+    return (st.st_mtime_ns, st.st_size)
+
+
+def watch(
+    path: Path,
+    on_update: Callable[[TrainingLog], None],
+    interval: float = 0.5,
+    settle: float = 0.2,
+    stop: Optional[Event] = None,
+) -> None:
+    """Watch a log file and call back with a fresh parse on every change.
+
+    Calls on_update once with the initial parse, then again each time the
+    file changes and its mtime settles (debouncing rapid successive
+    writes). Parse problems arrive as diagnostics on the log; they never
+    stop the watcher. Blocks until stop is set, so run it in a thread for
+    interactive use.
+
+    Args:
+        path: Log file to watch
+        on_update: Called with the freshly parsed TrainingLog
+        interval: Seconds between polls
+        settle: Seconds the mtime must hold still before reloading
+        stop: Event that ends the watch loop when set
+    """
+    from ox.cli import parse_file
+
+    path = Path(path)
+    if stop is None:
+        stop = Event()
+
+    def reload() -> None:
+        try:
+            log = parse_file(path)
+        except OSError:
+            return  # mid-write or deleted; pick it up on the next change
+        on_update(log)
+
+    last = _signature(path)
+    reload()
+    while not stop.wait(interval):
+        current = _signature(path)
+        if current == last:
+            continue
+        # Debounce: wait for the signature to hold still across a settle
+        # period before re-parsing.
+        while not stop.wait(settle):
+            settled = _signature(path)
+            if settled == current:
+                break
+            current = settled
+        last = current
+        if current is not None:
+            reload()
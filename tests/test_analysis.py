@@ -0,0 +1,862 @@
+"""Tests for cross-session analysis helpers and the plateau plugin."""
+
+from datetime import date, timedelta
+
+import pytest
+
+from ox.analysis import (
+    acwr,
+    acwr_zone,
+    compare,
+    compute_stats,
+    detect_anomalies,
+    detect_plateaus,
+    e1rm_trend,
+    FatigueWeights,
+    frequency,
+    low_volume_tags,
+    session_best_e1rms,
+    session_time_under_tension,
+    stimulus_fatigue,
+    time_under_tension,
+    velocity_zone,
+    weekly_sets_by_tag,
+)
+from ox.builtins.plateau import plateau, register
+from ox.data import (
+    Movement,
+    MovementDefinition,
+    TrainingLog,
+    TrainingSession,
+    TrainingSet,
+)
+from ox.plugins import PluginContext, TableResult
+from ox.units import ureg
+
+
+def _session(d, name, weight_kg, reps=5, flag="*"):
+    return TrainingSession(
+        date=d,
+        flag=flag,
+        name="Session",
+        movements=(Movement(name, [TrainingSet(reps, weight_kg * ureg.kg)], None),),
+    )
+
+
+def _weekly_log(name, weights, start=date(2025, 1, 5)):
+    """One session per week with the given top-set weights."""
+    sessions = tuple(
+        _session(start + timedelta(weeks=i), name, w) for i, w in enumerate(weights)
+    )
+    return TrainingLog(sessions=sessions)
+
+
+class TestSessionBestE1rms:
+    def test_one_entry_per_session(self):
+        log = _weekly_log("squat", [100, 102.5, 105])
+        history = session_best_e1rms(log)["squat"]
+        assert len(history) == 3
+        assert [d for d, _ in history] == sorted(d for d, _ in history)
+
+    def test_best_set_wins(self):
+        heavy = TrainingSession(
+            date=date(2025, 1, 5),
+            flag="*",
+            name="Session",
+            movements=(
+                Movement(
+                    "squat",
+                    [TrainingSet(5, 100 * ureg.kg), TrainingSet(1, 120 * ureg.kg)],
+                    None,
+                ),
+            ),
+        )
+        history = session_best_e1rms(TrainingLog(sessions=(heavy,)))["squat"]
+        assert history[0][1] >= 120.0
+
+    def test_bodyweight_sets_skipped(self):
+        session = TrainingSession(
+            date=date(2025, 1, 5),
+            flag="*",
+            name="Session",
+            movements=(Movement("pullups", [TrainingSet(10)], None),),
+        )
+        assert session_best_e1rms(TrainingLog(sessions=(session,))) == {}
+
+    def test_movement_filter(self):
+        log = _weekly_log("squat", [100, 105])
+        assert session_best_e1rms(log, movement="deadlift") == {}
+
+
+def _tagged_log(weeks=2, start=date(2025, 1, 5)):
+    """One squat session per week, three sets each, with tag definitions."""
+    sessions = tuple(
+        TrainingSession(
+            date=start + timedelta(weeks=i),
+            flag="*",
+            name="Session",
+            movements=(Movement("squat", [TrainingSet(5, 100 * ureg.kg)] * 3, None),),
+        )
+        for i in range(weeks)
+    )
+    return TrainingLog(
+        sessions=sessions,
+        movement_definitions=(
+            MovementDefinition("squat", tags=("quad", "knee-dominant")),
+            MovementDefinition("bench-press", tags=("press",)),
+        ),
+    )
+
+
+class TestWeeklySetsByTag:
+    def test_set_counts_toward_every_tag(self):
+        weekly = weekly_sets_by_tag(_tagged_log(weeks=2))
+        assert weekly["quad"] == 3.0
+        assert weekly["knee-dominant"] == 3.0
+
+    def test_untrained_tag_absent(self):
+        assert "press" not in weekly_sets_by_tag(_tagged_log())
+
+    def test_undefined_movement_ignored(self):
+        log = _tagged_log()
+        extra = TrainingSession(
+            date=date(2025, 1, 6),
+            flag="*",
+            name="Session",
+            movements=(Movement("mystery", [TrainingSet(10)], None),),
+        )
+        weekly = weekly_sets_by_tag(
+            TrainingLog(
+                sessions=log.sessions + (extra,),
+                movement_definitions=log.movement_definitions,
+            )
+        )
+        assert set(weekly) == {"quad", "knee-dominant"}
+
+    def test_date_range_widens_denominator(self):
+        # Two sessions in week one, but averaged over a four-week range
+        log = _tagged_log(weeks=2, start=date(2025, 1, 5))
+        weekly = weekly_sets_by_tag(log, start=date(2025, 1, 5), end=date(2025, 2, 1))
+        assert weekly["quad"] == 6 / 4
+
+    def test_empty_log(self):
+        assert weekly_sets_by_tag(TrainingLog(sessions=())) == {}
+
+
+class TestLowVolumeTags:
+    def test_flags_tags_below_minimum(self):
+        flagged = low_volume_tags(_tagged_log(), minimum=10.0)
+        assert ("press", 0.0) in flagged
+        assert ("quad", 3.0) in flagged
+
+    def test_tags_at_or_above_minimum_pass(self):
+        assert low_volume_tags(_tagged_log(), minimum=3.0) == [("press", 0.0)]
+
+    def test_sorted_by_tag(self):
+        flagged = low_volume_tags(_tagged_log(), minimum=10.0)
+        assert flagged == sorted(flagged)
+
+
+class TestDetectPlateaus:
+    def test_progressing_movement_not_flagged(self):
+        log = _weekly_log("squat", [100, 105, 110, 115, 120, 125])
+        assert detect_plateaus(log) == []
+
+    def test_stalled_movement_flagged(self):
+        log = _weekly_log("squat", [100, 105, 105, 105, 105, 105])
+        plateaus = detect_plateaus(log)
+        assert len(plateaus) == 1
+        p = plateaus[0]
+        assert p.movement == "squat"
+        assert p.last_improvement == date(2025, 1, 12)
+        assert p.window_end == log.sessions[-1].date
+
+    def test_too_few_sessions_in_window_skipped(self):
+        # Stalled, but only trained twice in the trailing 28 days
+        log = _weekly_log("squat", [100, 105, 105], start=date(2025, 1, 5))
+        assert detect_plateaus(log, min_sessions=3) == []
+
+    def test_min_gain_threshold(self):
+        # Tiny improvements below the gain threshold still count as a stall
+        log = _weekly_log("squat", [100, 100.2, 100.4, 100.6, 100.8, 100.9])
+        assert detect_plateaus(log, min_gain_kg=2.5) != []
+
+    def test_no_baseline_before_window_skipped(self):
+        # All sessions inside the window: nothing to stall against
+        log = _weekly_log("squat", [100, 100, 100], start=date(2025, 1, 5))
+        assert detect_plateaus(log, window_days=365) == []
+
+    def test_planned_sessions_ignored(self):
+        stalled = _weekly_log("squat", [100, 100, 100, 100, 100, 100])
+        planned = _session(date(2025, 3, 1), "squat", 200, flag="!")
+        log = TrainingLog(sessions=stalled.sessions + (planned,))
+        plateaus = detect_plateaus(log)
+        assert len(plateaus) == 1
+        assert plateaus[0].best_e1rm_kg < 200
+
+
+class TestPlateauPlugin:
+    def test_returns_table_result(self):
+        log = _weekly_log("squat", [100, 105, 105, 105, 105, 105])
+        result = plateau(PluginContext(db=None, log=log))
+        assert isinstance(result, TableResult)
+        assert len(result.rows) == 1
+        assert result.rows[0][0] == "squat"
+
+    def test_columns(self):
+        log = _weekly_log("squat", [100, 105, 110, 115, 120, 125])
+        result = plateau(PluginContext(db=None, log=log))
+        assert result.columns == [
+            "movement",
+            "stale_from",
+            "stale_to",
+            "last_improvement",
+            "best_e1rm (kg)",
+            "sessions",
+        ]
+        assert result.rows == []
+
+    def test_register_returns_descriptor(self):
+        descriptors = register()
+        assert len(descriptors) == 1
+        desc = descriptors[0]
+        assert desc["name"] == "plateau"
+        assert desc["fn"] is plateau
+        param_names = {p["name"] for p in desc["params"]}
+        assert param_names == {"window", "gain", "sessions"}
+
+
+class TestDensity:
+    def _session(self, sets):
+        return TrainingSession(
+            date=date(2025, 1, 5),
+            flag="*",
+            name="Conditioning",
+            movements=(Movement("kb-swing", sets, None),),
+        )
+
+    def test_reps_per_minute_of_rest(self):
+        from datetime import timedelta
+
+        from ox.analysis import density
+
+        sets = [TrainingSet(10, 24 * ureg.kg, rest=timedelta(minutes=1))] * 5
+        assert density(self._session(sets)) == 10.0
+
+    def test_unrested_sets_excluded(self):
+        from datetime import timedelta
+
+        from ox.analysis import density
+
+        sets = [
+            TrainingSet(10, 24 * ureg.kg, rest=timedelta(minutes=2)),
+            TrainingSet(100, 24 * ureg.kg),  # no rest recorded
+        ]
+        assert density(self._session(sets)) == 5.0
+
+    def test_no_rest_data_is_none(self):
+        from ox.analysis import density
+
+        sets = [TrainingSet(10, 24 * ureg.kg)]
+        assert density(self._session(sets)) is None
+
+
+class TestE1rmTrend:
+    def test_linear_progress_slope(self):
+        from ox.analysis import e1rm_trend
+
+        # One rep at 100, 102, 104kg a week apart: e1RM == weight, +2kg/week
+        log = TrainingLog(
+            sessions=tuple(
+                _session(date(2025, 1, 5) + timedelta(weeks=i), "squat", w, reps=1)
+                for i, w in enumerate([100, 102, 104])
+            )
+        )
+        slope, r2, points = e1rm_trend(log, "squat")
+        assert slope == pytest.approx(2.0)
+        assert r2 == pytest.approx(1.0)
+        assert len(points) == 3
+
+    def test_elapsed_days_not_index(self):
+        from ox.analysis import e1rm_trend
+
+        # Same weights, but the last session is four weeks late: the
+        # slope per week must shrink accordingly
+        dates = [
+            date(2025, 1, 5),
+            date(2025, 1, 12),
+            date(2025, 2, 9),
+        ]
+        log = TrainingLog(
+            sessions=tuple(
+                _session(d, "squat", w, reps=1) for d, w in zip(dates, [100, 102, 104])
+            )
+        )
+        slope, _, _ = e1rm_trend(log, "squat")
+        assert slope < 2.0
+
+    def test_flat_data(self):
+        from ox.analysis import e1rm_trend
+
+        log = _weekly_log("squat", [100, 100, 100])
+        slope, r2, _ = e1rm_trend(log, "squat")
+        assert slope == 0.0
+        assert r2 == 1.0
+
+    def test_too_few_points_raises(self):
+        from ox.analysis import e1rm_trend
+
+        log = _weekly_log("squat", [100, 105])
+        with pytest.raises(ValueError, match="at least 3 sessions"):
+            e1rm_trend(log, "squat")
+
+
+class TestAverageIntensity:
+    def test_percent_of_prior_best(self):
+        from ox.analysis import average_intensity
+
+        # 100kg x 5 gives a Brzycki e1RM well above 100; a later 90kg day
+        # sits below 90% of it
+        log = _weekly_log("squat", [100, 90])
+        intensity = average_intensity(log.sessions[1], log)
+        expected = 100 * 90 / (100 * 36 / (37 - 5))
+        assert intensity["squat"] == pytest.approx(expected)
+
+    def test_later_sessions_do_not_leak(self):
+        from ox.analysis import average_intensity
+
+        # A big PR after the assessed session must not lower its intensity
+        log = _weekly_log("squat", [100, 90, 150])
+        with_pr = average_intensity(log.sessions[1], log)
+        without_pr = average_intensity(
+            log.sessions[1], TrainingLog(sessions=log.sessions[:2])
+        )
+        assert with_pr["squat"] == pytest.approx(without_pr["squat"])
+
+    def test_first_session_skipped(self):
+        from ox.analysis import average_intensity
+
+        log = _weekly_log("squat", [100, 105])
+        assert average_intensity(log.sessions[0], log) == {}
+
+    def test_bodyweight_movement_skipped(self):
+        from ox.analysis import average_intensity
+
+        session = TrainingSession(
+            date=date(2025, 1, 12),
+            flag="*",
+            name="Session",
+            movements=(Movement("pullups", [TrainingSet(10)], None),),
+        )
+        log = _weekly_log("squat", [100])
+        assert average_intensity(session, log) == {}
+
+
+class TestTimeUnderTension:
+    def test_set_tut_is_tempo_times_reps(self):
+        s = TrainingSet(5, 100 * ureg.kg, tempo=(3, 1, 1, 0))
+        assert time_under_tension(s) == timedelta(seconds=25)
+
+    def test_no_tempo_returns_none(self):
+        assert time_under_tension(TrainingSet(5, 100 * ureg.kg)) is None
+
+    def test_zero_tempo_is_distinguishable_from_absent(self):
+        s = TrainingSet(5, 100 * ureg.kg, tempo=(0, 0, 0, 0))
+        assert time_under_tension(s) == timedelta(0)
+
+    def test_session_tut_sums_tempo_sets(self):
+        session = TrainingSession(
+            date=date(2025, 1, 6),
+            flag="*",
+            name="Day",
+            movements=(
+                Movement(
+                    "squat",
+                    [
+                        TrainingSet(5, 100 * ureg.kg, tempo=(3, 1, 1, 0)),
+                        TrainingSet(5, 100 * ureg.kg),  # excluded: no tempo
+                    ],
+                    None,
+                ),
+            ),
+        )
+        assert session_time_under_tension(session) == timedelta(seconds=25)
+
+    def test_session_without_tempo_returns_none(self):
+        session = TrainingSession(
+            date=date(2025, 1, 6),
+            flag="*",
+            name="Day",
+            movements=(Movement("squat", [TrainingSet(5, 100 * ureg.kg)], None),),
+        )
+        assert session_time_under_tension(session) is None
+
+
+class TestAcwr:
+    AS_OF = date(2025, 3, 1)
+
+    def _steady_log(self, days=28, kg=100, spike_last_week=False):
+        sessions = []
+        for i in range(days):
+            day = self.AS_OF - timedelta(days=i)
+            weight = kg * 2 if spike_last_week and i < 7 else kg
+            sessions.append(
+                TrainingSession(
+                    date=day,
+                    flag="*",
+                    name="Day",
+                    movements=(
+                        Movement("squat", [TrainingSet(5, weight * ureg.kg)], None),
+                    ),
+                )
+            )
+        return TrainingLog(sessions=tuple(sessions))
+
+    def test_steady_load_is_one(self):
+        assert acwr(self._steady_log(), self.AS_OF) == 1.0
+
+    def test_spike_raises_ratio(self):
+        value = acwr(self._steady_log(spike_last_week=True), self.AS_OF)
+        assert value == pytest.approx(1.6)
+        assert acwr_zone(value) == "danger"
+
+    def test_insufficient_history_returns_none(self):
+        assert acwr(self._steady_log(days=14), self.AS_OF) is None
+
+    def test_empty_log_returns_none(self):
+        assert acwr(TrainingLog(sessions=()), self.AS_OF) is None
+
+    def test_sets_metric_counts_bodyweight_work(self):
+        sessions = tuple(
+            TrainingSession(
+                date=self.AS_OF - timedelta(days=i),
+                flag="*",
+                name="Day",
+                movements=(Movement("pullups", [TrainingSet(10, None)] * 3, None),),
+            )
+            for i in range(28)
+        )
+        log = TrainingLog(sessions=sessions)
+        assert acwr(log, self.AS_OF, metric="tonnage") is None
+        assert acwr(log, self.AS_OF, metric="sets") == 1.0
+        assert acwr(log, self.AS_OF, metric="reps") == 1.0
+
+    def test_unknown_metric_raises(self):
+        with pytest.raises(ValueError, match="Unknown ACWR metric"):
+            acwr(self._steady_log(), self.AS_OF, metric="volume")
+
+
+class TestAcwrZone:
+    @pytest.mark.parametrize(
+        "value,expected",
+        [
+            (None, "N/A"),
+            (0.5, "undertraining"),
+            (1.0, "sweet spot"),
+            (1.3, "sweet spot"),
+            (1.4, "caution"),
+            (1.8, "danger"),
+        ],
+    )
+    def test_zones(self, value, expected):
+        assert acwr_zone(value) == expected
+
+
+class TestDetectAnomalies:
+    def _log(self, weights, reps=5):
+        sessions = tuple(
+            _session(date(2025, 1, 5) + timedelta(weeks=i), "squat", w, reps=reps)
+            for i, w in enumerate(weights)
+        )
+        return TrainingLog(sessions=sessions)
+
+    def test_normal_progression_clean(self):
+        assert detect_anomalies(self._log([100, 102.5, 105, 107.5])) == []
+
+    def test_conversion_noise_at_bound_not_flagged(self):
+        # Exactly 50% above the recent max, plus lb/kg round-trip noise:
+        # still within epsilon of the bound, so no anomaly
+        noisy = float((150 * ureg.kg).to("pound").to("kilogram").magnitude)
+        assert detect_anomalies(self._log([100, noisy])) == []
+
+    def test_typo_weight_flagged_as_error(self):
+        anomalies = detect_anomalies(self._log([100, 102.5, 1000]))
+        assert len(anomalies) == 1
+        a = anomalies[0]
+        assert a.movement == "squat"
+        assert a.severity == "error"
+        assert a.training_set.weight_kg == 1000.0
+        assert a.expected_max == pytest.approx(153.8)
+        assert "recent max" in a.reason
+
+    def test_moderate_jump_is_warning(self):
+        anomalies = detect_anomalies(self._log([100, 160]))
+        assert [a.severity for a in anomalies] == ["warning"]
+
+    def test_jump_threshold_configurable(self):
+        assert detect_anomalies(self._log([100, 160]), max_jump_pct=75.0) == []
+
+    def test_first_appearance_has_no_baseline(self):
+        assert detect_anomalies(self._log([1000])) == []
+
+    def test_implausible_reps_flagged(self):
+        anomalies = detect_anomalies(self._log([100], reps=500))
+        assert len(anomalies) == 1
+        assert anomalies[0].severity == "error"
+        assert anomalies[0].expected_max == 100.0
+        assert "reps" in anomalies[0].reason
+
+    def test_typo_does_not_become_new_baseline(self):
+        # The 1000kg typo is excluded from history, so a repeat is still flagged
+        anomalies = detect_anomalies(self._log([100, 1000, 1000]))
+        assert len(anomalies) == 2
+        assert all(a.severity == "error" for a in anomalies)
+
+    def test_planned_sessions_ignored(self):
+        planned = _session(date(2025, 2, 1), "squat", 1000, flag="!")
+        log = TrainingLog(sessions=self._log([100]).sessions + (planned,))
+        assert detect_anomalies(log) == []
+
+
+class TestStimulusFatigue:
+    def _session_with(self, rpe=None, rir=None, kg=100, n_sets=3):
+        sets = [TrainingSet(5, kg * ureg.kg, rpe=rpe, rir=rir)] * n_sets
+        return TrainingSession(
+            date=date(2025, 1, 10),
+            flag="*",
+            name="Day",
+            movements=(Movement("squat", sets, None),),
+        )
+
+    def test_harder_effort_scores_higher(self):
+        easy = self._session_with(rpe=6)
+        hard = self._session_with(rpe=9)
+        log = TrainingLog(sessions=(hard,))
+        assert stimulus_fatigue(hard, log) > stimulus_fatigue(easy, log)
+
+    def test_no_rpe_falls_back_to_intensity(self):
+        session = self._session_with()
+        log = TrainingLog(sessions=(session,))
+        index = stimulus_fatigue(session, log)
+        # set_cost alone would be 3.0; intensity still contributes
+        assert index > 3.0
+
+    def test_effort_weight_zero_ignores_rpe(self):
+        with_rpe = self._session_with(rpe=9)
+        without = self._session_with()
+        log = TrainingLog(sessions=(with_rpe,))
+        weights = FatigueWeights(effort=0.0)
+        assert stimulus_fatigue(with_rpe, log, weights) == stimulus_fatigue(
+            without, log, weights
+        )
+
+    def test_rir_zero_equals_rpe_ten(self):
+        by_rpe = self._session_with(rpe=10)
+        by_rir = self._session_with(rir=0)
+        log = TrainingLog(sessions=(by_rpe,))
+        assert stimulus_fatigue(by_rpe, log) == stimulus_fatigue(by_rir, log)
+
+    def test_relative_intensity_uses_log_history(self):
+        # Same absolute session scores lower once the log holds a heavier best
+        session = self._session_with(kg=100)
+        weak_log = TrainingLog(sessions=(session,))
+        strong_log = TrainingLog(
+            sessions=(
+                session,
+                self._session_with(kg=140),
+            )
+        )
+        assert stimulus_fatigue(session, strong_log) < stimulus_fatigue(
+            session, weak_log
+        )
+
+    def test_empty_session_scores_zero(self):
+        session = TrainingSession(
+            date=date(2025, 1, 10), flag="*", name="Rest", movements=()
+        )
+        assert stimulus_fatigue(session, TrainingLog(sessions=())) == 0.0
+
+    def test_negative_weight_raises(self):
+        with pytest.raises(ValueError, match="non-negative"):
+            FatigueWeights(effort=-1.0)
+
+
+class TestFrequency:
+    def _log(self):
+        # Squat twice a week for two weeks, deadlift once
+        days = [
+            date(2025, 1, 6),
+            date(2025, 1, 9),
+            date(2025, 1, 13),
+            date(2025, 1, 16),
+        ]
+        sessions = tuple(_session(d, "squat", 100) for d in days) + (
+            _session(date(2025, 1, 7), "deadlift", 140),
+        )
+        return TrainingLog(
+            sessions=sessions,
+            movement_definitions=(
+                MovementDefinition("squat", tags=("squat", "lower")),
+                MovementDefinition("deadlift", tags=("hinge", "lower")),
+            ),
+        )
+
+    def test_sessions_per_week(self):
+        freq = frequency(self._log())
+        assert freq["squat"].sessions == 4
+        # 11-day range -> 4 / (11/7)
+        assert freq["squat"].per_week == pytest.approx(2.55, abs=0.01)
+
+    def test_average_gap(self):
+        freq = frequency(self._log())
+        assert freq["squat"].avg_gap_days == pytest.approx(3.3)
+
+    def test_single_appearance_has_no_gap(self):
+        freq = frequency(self._log())
+        assert freq["deadlift"].sessions == 1
+        assert freq["deadlift"].avg_gap_days is None
+
+    def test_group_by_tag(self):
+        freq = frequency(self._log(), by_tag=True)
+        assert freq["lower"].sessions == 5
+        assert "squat" in freq
+        assert "hinge" in freq
+
+    def test_date_range_filters(self):
+        freq = frequency(self._log(), start=date(2025, 1, 13), end=date(2025, 1, 19))
+        assert freq["squat"].sessions == 2
+        assert "deadlift" not in freq
+
+    def test_explicit_range_widens_denominator(self):
+        freq = frequency(self._log(), start=date(2025, 1, 1), end=date(2025, 1, 28))
+        assert freq["squat"].per_week == 1.0
+
+    def test_empty_log(self):
+        assert frequency(TrainingLog(sessions=())) == {}
+
+    def test_duplicate_movement_lines_count_once_per_day(self):
+        day = date(2025, 1, 6)
+        session = TrainingSession(
+            date=day,
+            flag="*",
+            name="Day",
+            movements=(
+                Movement("squat", [TrainingSet(5, 100 * ureg.kg)], None),
+                Movement("squat", [TrainingSet(5, 100 * ureg.kg)], None),
+            ),
+        )
+        freq = frequency(TrainingLog(sessions=(session,)))
+        assert freq["squat"].sessions == 1
+
+
+class TestWarmupAnalysisExclusion:
+    def test_warmup_sets_do_not_drive_e1rm(self):
+        session = TrainingSession(
+            date=date(2025, 1, 10),
+            flag="*",
+            name="Day",
+            movements=(
+                Movement(
+                    "squat",
+                    [
+                        TrainingSet(5, 140 * ureg.kg, warmup=True),
+                        TrainingSet(5, 100 * ureg.kg),
+                    ],
+                    None,
+                ),
+            ),
+        )
+        history = session_best_e1rms(TrainingLog(sessions=(session,)))
+        _, e1rm = history["squat"][0]
+        assert e1rm < 140
+
+    def test_warmup_only_movement_has_no_history(self):
+        session = TrainingSession(
+            date=date(2025, 1, 10),
+            flag="*",
+            name="Day",
+            movements=(
+                Movement("squat", [TrainingSet(5, 60 * ureg.kg, warmup=True)], None),
+            ),
+        )
+        assert session_best_e1rms(TrainingLog(sessions=(session,))) == {}
+
+
+class TestVelocityZone:
+    def test_no_velocity_returns_none(self):
+        assert velocity_zone(TrainingSet(5, 100 * ureg.kg)) is None
+
+    def test_absolute_strength_below_half(self):
+        s = TrainingSet(3, 180 * ureg.kg, velocity=0.3)
+        assert velocity_zone(s) == "absolute strength"
+
+    def test_zone_boundaries_are_inclusive(self):
+        assert velocity_zone(TrainingSet(3, velocity=0.5)) == "accelerative strength"
+        assert velocity_zone(TrainingSet(3, velocity=0.75)) == "strength-speed"
+        assert velocity_zone(TrainingSet(3, velocity=1.0)) == "speed-strength"
+        assert velocity_zone(TrainingSet(3, velocity=1.3)) == "starting strength"
+
+    def test_fast_set_is_starting_strength(self):
+        assert velocity_zone(TrainingSet(3, velocity=1.6)) == "starting strength"
+
+
+class TestLogStats:
+    def _log(self):
+        return TrainingLog(
+            sessions=(
+                _session(date(2025, 1, 6), "squat", 100),
+                _session(date(2025, 1, 13), "squat", 105),
+                _session(date(2025, 1, 20), "squat", 110),
+                _session(date(2025, 1, 7), "bench-press", 80),
+            ),
+            movement_definitions=(
+                MovementDefinition("squat", tags=("squat", "lower")),
+                MovementDefinition("bench-press", tags=("press",)),
+            ),
+        )
+
+    def test_e1rm_history_matches_direct_scan(self):
+        log = self._log()
+        stats = compute_stats(log)
+        assert session_best_e1rms(log, stats=stats) == session_best_e1rms(log)
+
+    def test_single_movement_lookup_matches(self):
+        log = self._log()
+        stats = compute_stats(log)
+        assert session_best_e1rms(log, movement="squat", stats=stats) == (
+            session_best_e1rms(log, movement="squat")
+        )
+        assert session_best_e1rms(log, movement="deadlift", stats=stats) == {}
+
+    def test_sets_by_movement_is_chronological(self):
+        stats = compute_stats(self._log())
+        dates = [d for d, _ in stats.sets_by_movement["squat"]]
+        assert dates == sorted(dates)
+        assert len(dates) == 3
+
+    def test_sets_by_movement_keeps_warmups(self):
+        warm = TrainingSession(
+            date=date(2025, 1, 6),
+            flag="*",
+            name="Session",
+            movements=(
+                Movement(
+                    "squat",
+                    [
+                        TrainingSet(5, 60 * ureg.kg, warmup=True),
+                        TrainingSet(5, 100 * ureg.kg),
+                    ],
+                    None,
+                ),
+            ),
+        )
+        stats = compute_stats(TrainingLog(sessions=(warm,)))
+        assert len(stats.sets_by_movement["squat"]) == 2
+        # but warmups stay out of the e1RM history baseline
+        assert len(stats.e1rm_history["squat"]) == 1
+
+    def test_movements_by_tag(self):
+        stats = compute_stats(self._log())
+        assert stats.movements_by_tag["squat"] == ("squat",)
+        assert stats.movements_by_tag["press"] == ("bench-press",)
+
+    def test_trend_and_plateaus_match_with_stats(self):
+        log = _weekly_log("squat", [100, 100, 100, 100, 100, 100])
+        stats = compute_stats(log)
+        assert e1rm_trend(log, "squat", stats=stats) == e1rm_trend(log, "squat")
+        assert detect_plateaus(log, stats=stats) == detect_plateaus(log)
+
+    def _ten_thousand_set_log(self):
+        sessions = []
+        day = date(2020, 1, 6)
+        for i in range(2000):
+            sessions.append(
+                TrainingSession(
+                    date=day + timedelta(days=i),
+                    flag="*",
+                    name="Session",
+                    movements=(
+                        Movement(
+                            f"movement-{i % 20}",
+                            [TrainingSet(5, (100 + i % 40) * ureg.kg)] * 5,
+                            None,
+                        ),
+                    ),
+                )
+            )
+        return TrainingLog(sessions=tuple(sessions))
+
+    def test_large_log_single_pass(self):
+        # 10k sets: stats answers match a direct scan of the same log
+        log = self._ten_thousand_set_log()
+        stats = compute_stats(log)
+        assert sum(len(v) for v in stats.sets_by_movement.values()) == 10000
+        assert session_best_e1rms(log, stats=stats) == session_best_e1rms(log)
+
+    def test_cached_lookups_beat_rescans(self):
+        # The point of LogStats: answering from the index must be faster
+        # than rescanning a 10k-set log, even after paying to build it
+        from time import perf_counter
+
+        log = self._ten_thousand_set_log()
+        rounds = 10
+
+        start = perf_counter()
+        for _ in range(rounds):
+            session_best_e1rms(log)
+        direct = perf_counter() - start
+
+        start = perf_counter()
+        stats = compute_stats(log)
+        for _ in range(rounds):
+            session_best_e1rms(log, stats=stats)
+        cached = perf_counter() - start
+
+        assert cached < direct
+
+
+class TestOpenEndedAmrap:
+    """An open-ended AMRAP set (reps=0) must not crash the e1RM paths."""
+
+    def _log(self, tmp_path):
+        from ox.cli import parse_file
+
+        f = tmp_path / "amrap.ox"
+        f.write_text(
+            "2025-01-06 * bench-press: 80kg 3x5\n2025-01-13 * bench-press: 80kg 1xAMRAP\n"
+        )
+        return parse_file(f)
+
+    def test_session_best_e1rms_skips_open_amrap(self, tmp_path):
+        log = self._log(tmp_path)
+        history = session_best_e1rms(log)["bench-press"]
+        assert [d for d, _ in history] == [date(2025, 1, 6)]
+
+    def test_compute_stats_skips_open_amrap(self, tmp_path):
+        log = self._log(tmp_path)
+        stats = compute_stats(log)
+        assert session_best_e1rms(log, stats=stats) == session_best_e1rms(log)
+
+    def test_personal_records_skip_open_amrap(self, tmp_path):
+        record = self._log(tmp_path).personal_records()["bench-press"]
+        assert record.best_e1rm_date == date(2025, 1, 6)
+        assert record.max_weight_date == date(2025, 1, 6)
+
+    def test_compare_handles_open_amrap(self, tmp_path):
+        log = self._log(tmp_path)
+        report = compare(
+            log,
+            date(2025, 1, 6),
+            date(2025, 1, 12),
+            date(2025, 1, 13),
+            date(2025, 1, 19),
+        )
+        assert report is not None
+
+    def test_achieved_amrap_still_counts(self, tmp_path):
+        from ox.cli import parse_file
+
+        f = tmp_path / "amrap.ox"
+        f.write_text("2025-01-06 * bench-press: 80kg 1xAMRAP(12)\n")
+        log = parse_file(f)
+        assert "bench-press" in session_best_e1rms(log)
@@ -0,0 +1,83 @@
+"""Tests for the ASCII progress chart plugin."""
+
+from datetime import date, timedelta
+
+import pytest
+
+from ox.builtins.chart import METRICS, _session_series, chart, register
+from ox.data import Movement, TrainingLog, TrainingSession, TrainingSet
+from ox.plugins import PluginContext, PlotResult
+from ox.units import ureg
+
+
+def _log(weights, start=date(2025, 1, 5)):
+    sessions = tuple(
+        TrainingSession(
+            date=start + timedelta(weeks=i),
+            flag="*",
+            name="Session",
+            movements=(
+                Movement(
+                    "squat",
+                    [TrainingSet(5, w * ureg.kg), TrainingSet(3, (w + 10) * ureg.kg)],
+                    None,
+                ),
+            ),
+        )
+        for i, w in enumerate(weights)
+    )
+    return TrainingLog(sessions=sessions)
+
+
+def _ctx(log):
+    return PluginContext(db=None, log=log)
+
+
+class TestSessionSeries:
+    def test_weight_is_top_set(self):
+        points = _session_series(_log([100, 105]), "squat", "weight")
+        assert points == [("2025-01-05", 110.0), ("2025-01-12", 115.0)]
+
+    def test_volume_is_session_tonnage(self):
+        points = _session_series(_log([100]), "squat", "volume")
+        assert points == [("2025-01-05", 5 * 100.0 + 3 * 110.0)]
+
+    def test_e1rm_increases_with_weight(self):
+        points = _session_series(_log([100, 105]), "squat", "e1rm")
+        assert len(points) == 2
+        assert points[1][1] > points[0][1]
+
+    def test_unknown_movement_empty(self):
+        assert _session_series(_log([100]), "deadlift", "weight") == []
+
+
+class TestChartPlugin:
+    def test_returns_plot_result(self):
+        result = chart(_ctx(_log([100, 105, 110])), "squat")
+        assert isinstance(result, PlotResult)
+        assert any("e1rm (kg)" in line for line in result.lines)
+
+    def test_title_is_movement(self):
+        result = chart(_ctx(_log([100, 105, 110])), "squat")
+        assert any("squat" in line for line in result.lines)
+
+    def test_no_data_message(self):
+        result = chart(_ctx(_log([100])), "deadlift")
+        assert result.lines == ["No weighted sets found for 'deadlift'."]
+
+    def test_unknown_metric_raises(self):
+        with pytest.raises(ValueError, match="Unknown metric"):
+            chart(_ctx(_log([100])), "squat", metric="tonnage")
+
+    def test_metrics_tuple(self):
+        assert METRICS == ("e1rm", "weight", "volume")
+
+
+def test_register_returns_descriptor():
+    descriptors = register()
+    assert len(descriptors) == 1
+    desc = descriptors[0]
+    assert desc["name"] == "chart"
+    assert desc["fn"] is chart
+    param_names = {p["name"] for p in desc["params"]}
+    assert param_names == {"movement", "metric", "width"}
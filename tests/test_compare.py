@@ -0,0 +1,125 @@
+"""Tests for the period comparison report."""
+
+from datetime import date
+
+import pytest
+
+from ox.analysis import compare, percent_change
+from ox.builtins.compare import compare as compare_plugin
+from ox.data import Movement, TrainingLog, TrainingSession, TrainingSet
+from ox.plugins import PluginContext, TableResult
+from ox.units import ureg
+
+A = (date(2025, 1, 1), date(2025, 1, 31))
+B = (date(2025, 2, 1), date(2025, 2, 28))
+
+
+def _session(day, movements):
+    return TrainingSession(date=day, flag="*", name="Day", movements=movements)
+
+
+@pytest.fixture
+def two_period_log():
+    # January: squat 100kg 5x5, bench 60kg 5x5. February: squat heavier,
+    # bench dropped, deadlift added.
+    return TrainingLog(
+        sessions=(
+            _session(
+                date(2025, 1, 10),
+                (
+                    Movement("squat", [TrainingSet(5, 100 * ureg.kg)] * 5, None),
+                    Movement("bench-press", [TrainingSet(5, 60 * ureg.kg)] * 5, None),
+                ),
+            ),
+            _session(
+                date(2025, 2, 10),
+                (
+                    Movement("squat", [TrainingSet(5, 110 * ureg.kg)] * 5, None),
+                    Movement("deadlift", [TrainingSet(5, 140 * ureg.kg)] * 5, None),
+                ),
+            ),
+        )
+    )
+
+
+class TestCompare:
+    def test_tonnage_and_sets(self, two_period_log):
+        report = compare(two_period_log, *A, *B)
+        assert report.tonnage_a_kg == 4000.0  # (100 + 60) * 25
+        assert report.tonnage_b_kg == 6250.0  # (110 + 140) * 25
+        assert report.sets_a == 10
+        assert report.sets_b == 10
+
+    def test_average_intensity(self, two_period_log):
+        report = compare(two_period_log, *A, *B)
+        assert report.avg_intensity_a_kg == 80.0
+        assert report.avg_intensity_b_kg == 125.0
+
+    def test_common_movement_has_delta(self, two_period_log):
+        report = compare(two_period_log, *A, *B)
+        squat = next(m for m in report.movements if m.movement == "squat")
+        assert squat.status == "common"
+        assert squat.delta_kg == round(squat.best_b_kg - squat.best_a_kg, 1)
+        assert squat.delta_pct == pytest.approx(10.0, abs=0.1)
+
+    def test_added_and_dropped_movements_flagged(self, two_period_log):
+        report = compare(two_period_log, *A, *B)
+        by_name = {m.movement: m for m in report.movements}
+        assert by_name["deadlift"].status == "added"
+        assert by_name["deadlift"].best_a_kg is None
+        assert by_name["deadlift"].delta_kg is None
+        assert by_name["bench-press"].status == "dropped"
+        assert by_name["bench-press"].best_b_kg is None
+
+    def test_movements_sorted_by_name(self, two_period_log):
+        report = compare(two_period_log, *A, *B)
+        names = [m.movement for m in report.movements]
+        assert names == sorted(names)
+
+    def test_empty_periods(self):
+        report = compare(TrainingLog(sessions=()), *A, *B)
+        assert report.tonnage_a_kg == 0.0
+        assert report.sets_b == 0
+        assert report.avg_intensity_a_kg is None
+        assert report.movements == ()
+
+
+class TestPercentChange:
+    def test_basic(self):
+        assert percent_change(100.0, 110.0) == 10.0
+
+    def test_zero_baseline_undefined(self):
+        assert percent_change(0, 10.0) is None
+
+    def test_none_undefined(self):
+        assert percent_change(None, 10.0) is None
+
+
+class TestComparePlugin:
+    def test_returns_table(self, two_period_log):
+        result = compare_plugin(
+            PluginContext(db=None, log=two_period_log),
+            a="2025-01-01:2025-01-31",
+            b="2025-02-01:2025-02-28",
+        )
+        assert isinstance(result, TableResult)
+        metrics = [r[0] for r in result.rows]
+        assert "tonnage (kg)" in metrics
+        assert "e1rm squat (kg)" in metrics
+
+    def test_added_movement_rendered_as_status(self, two_period_log):
+        result = compare_plugin(
+            PluginContext(db=None, log=two_period_log),
+            a="2025-01-01:2025-01-31",
+            b="2025-02-01:2025-02-28",
+        )
+        row = next(r for r in result.rows if r[0] == "e1rm deadlift (kg)")
+        assert row[3] == "added"
+
+    def test_bad_range_raises(self, two_period_log):
+        with pytest.raises(ValueError, match="Expected YYYY-MM-DD:YYYY-MM-DD"):
+            compare_plugin(
+                PluginContext(db=None, log=two_period_log),
+                a="2025-01-01",
+                b="2025-02-01:2025-02-28",
+            )
@@ -0,0 +1,133 @@
+"""Tests for streak and consistency metrics."""
+
+from datetime import date
+
+from ox.analysis import consistency
+from ox.builtins.consistency import consistency as consistency_plugin
+from ox.data import Movement, RestDay, TrainingLog, TrainingSession, TrainingSet
+from ox.plugins import PluginContext, TableResult
+from ox.units import ureg
+
+
+def _session(day):
+    return TrainingSession(
+        date=day,
+        flag="*",
+        name="Day",
+        movements=(Movement("squat", [TrainingSet(5, 100 * ureg.kg)], None),),
+    )
+
+
+def _log(days, rest_days=()):
+    return TrainingLog(
+        sessions=tuple(_session(d) for d in days),
+        rest_days=tuple(RestDay(date=d) for d in rest_days),
+    )
+
+
+class TestConsistency:
+    def test_empty_log(self):
+        result = consistency(TrainingLog(sessions=()))
+        assert result.training_days == 0
+        assert result.longest_streak == 0
+        assert result.current_streak == 0
+        assert result.avg_sessions_per_week == 0.0
+
+    def test_training_days_counts_calendar_days(self):
+        # Two sessions on the same day count once
+        log = TrainingLog(
+            sessions=(_session(date(2025, 1, 6)), _session(date(2025, 1, 6)))
+        )
+        assert consistency(log).training_days == 1
+
+    def test_longest_streak_consecutive_days(self):
+        log = _log(
+            [
+                date(2025, 1, 6),
+                date(2025, 1, 7),
+                date(2025, 1, 8),
+                # gap
+                date(2025, 1, 10),
+            ]
+        )
+        assert consistency(log).longest_streak == 3
+
+    def test_unmarked_gap_breaks_streak(self):
+        log = _log([date(2025, 1, 6), date(2025, 1, 8)])
+        assert consistency(log).longest_streak == 1
+
+    def test_marked_rest_day_preserves_streak(self):
+        log = _log(
+            [date(2025, 1, 6), date(2025, 1, 8)],
+            rest_days=[date(2025, 1, 7)],
+        )
+        result = consistency(log)
+        # The rest day bridges the run but isn't a training day itself
+        assert result.longest_streak == 2
+        assert result.training_days == 2
+
+    def test_current_streak_as_of_end(self):
+        log = _log([date(2025, 1, 6), date(2025, 1, 9), date(2025, 1, 10)])
+        assert consistency(log).current_streak == 2
+
+    def test_current_streak_zero_when_range_ends_on_gap(self):
+        log = _log([date(2025, 1, 6)])
+        assert consistency(log, end=date(2025, 1, 9)).current_streak == 0
+
+    def test_avg_sessions_per_week(self):
+        # 4 sessions across two calendar weeks
+        log = _log(
+            [date(2025, 1, 6), date(2025, 1, 8), date(2025, 1, 13), date(2025, 1, 15)]
+        )
+        assert consistency(log).avg_sessions_per_week == 2.0
+
+    def test_date_range_restricts_sessions(self):
+        log = _log([date(2025, 1, 6), date(2025, 2, 3)])
+        result = consistency(log, start=date(2025, 2, 1))
+        assert result.training_days == 1
+
+
+class TestRestEntry:
+    def test_parses_rest_entry(self, tmp_path):
+        from ox.cli import parse_file
+
+        f = tmp_path / "rest.ox"
+        f.write_text(
+            "2025-01-06 * squat: 100kg 5x5\n"
+            '2025-01-07 rest "planned off day"\n'
+            "2025-01-08 * squat: 102.5kg 5x5\n"
+        )
+        log = parse_file(f)
+        assert log.diagnostics == ()
+        assert len(log.rest_days) == 1
+        assert log.rest_days[0].date == date(2025, 1, 7)
+        assert log.rest_days[0].note == "planned off day"
+        assert consistency(log).longest_streak == 2
+
+    def test_rest_entry_note_optional(self, tmp_path):
+        from ox.cli import parse_file
+
+        f = tmp_path / "rest.ox"
+        f.write_text("2025-01-07 rest\n")
+        log = parse_file(f)
+        assert log.diagnostics == ()
+        assert log.rest_days[0].note is None
+
+    def test_rest_day_to_ox_round_trip(self):
+        assert RestDay(date(2025, 1, 7)).to_ox() == "2025-01-07 rest"
+        assert RestDay(date(2025, 1, 7), note="off").to_ox() == '2025-01-07 rest "off"'
+
+
+class TestConsistencyPlugin:
+    def test_returns_table(self):
+        log = _log([date(2025, 1, 6), date(2025, 1, 7)])
+        result = consistency_plugin(PluginContext(db=None, log=log))
+        assert isinstance(result, TableResult)
+        rows = dict(result.rows)
+        assert rows["training days"] == 2
+        assert rows["longest streak"] == 2
+
+    def test_end_argument(self):
+        log = _log([date(2025, 1, 6)])
+        result = consistency_plugin(PluginContext(db=None, log=log), end="2025-01-09")
+        assert dict(result.rows)["current streak"] == 0
@@ -305,3 +305,541 @@ class TestTrainingLog:
         assert len(log.planned_sessions) == 1
         assert log.completed_sessions[0].name == "Completed"
         assert log.planned_sessions[0].name == "Planned"
+
+
+class TestFilter:
+    """Test TrainingLog.filter() slicing by movement, tag, and date range."""
+
+    @pytest.fixture
+    def tagged_log(self):
+        """Log with movement definitions so tags resolve to movements."""
+        from ox.data import MovementDefinition
+
+        deadlift_day = TrainingSession(
+            date=date(2025, 1, 10),
+            flag="*",
+            name="Pull Day",
+            movements=(
+                Movement("deadlift", [TrainingSet(5, 315 * ureg.pounds)], None),
+            ),
+        )
+        rdl_day = TrainingSession(
+            date=date(2025, 1, 14),
+            flag="*",
+            name="Accessory Day",
+            movements=(
+                Movement(
+                    "romanian-deadlift", [TrainingSet(8, 225 * ureg.pounds)], None
+                ),
+            ),
+        )
+        press_day = TrainingSession(
+            date=date(2025, 1, 12),
+            flag="*",
+            name="Press Day",
+            movements=(
+                Movement("bench-press", [TrainingSet(5, 135 * ureg.pounds)], None),
+            ),
+        )
+        return TrainingLog(
+            sessions=(deadlift_day, rdl_day, press_day),
+            movement_definitions=(
+                MovementDefinition(name="deadlift", tags=("hip-hinge", "lower")),
+                MovementDefinition(name="romanian-deadlift", tags=("hip-hinge",)),
+                MovementDefinition(name="bench-press", tags=("press", "upper")),
+            ),
+        )
+
+    def test_no_constraints_returns_all_chronological(self, tagged_log):
+        result = tagged_log.filter()
+        assert len(result) == 3
+        assert [s.date for s in result] == sorted(s.date for s in result)
+
+    def test_filter_by_movement(self, tagged_log):
+        result = tagged_log.filter(movement="deadlift")
+        assert len(result) == 1
+        assert result[0].name == "Pull Day"
+
+    def test_filter_by_tag_resolves_definitions(self, tagged_log):
+        result = tagged_log.filter(tag="hip-hinge")
+        assert len(result) == 2
+        assert {s.name for s in result} == {"Pull Day", "Accessory Day"}
+
+    def test_filter_by_date_range_inclusive(self, tagged_log):
+        result = tagged_log.filter(start=date(2025, 1, 12), end=date(2025, 1, 14))
+        assert {s.name for s in result} == {"Press Day", "Accessory Day"}
+
+    def test_filter_combined(self, tagged_log):
+        result = tagged_log.filter(tag="hip-hinge", end=date(2025, 1, 12))
+        assert len(result) == 1
+        assert result[0].name == "Pull Day"
+
+    def test_filter_unknown_tag_matches_nothing(self, tagged_log):
+        assert tagged_log.filter(tag="no-such-tag") == ()
+
+    def test_filter_matches_set_level_tags(self, tagged_log):
+        paused_day = TrainingSession(
+            date=date(2025, 1, 16),
+            flag="*",
+            name="Paused Day",
+            movements=(
+                Movement(
+                    "bench-press",
+                    [TrainingSet(3, 135 * ureg.pounds, tags=("paused",))],
+                    None,
+                ),
+            ),
+        )
+        log = TrainingLog(
+            sessions=tagged_log.sessions + (paused_day,),
+            movement_definitions=tagged_log.movement_definitions,
+        )
+        result = log.filter(tag="paused")
+        assert [s.name for s in result] == ["Paused Day"]
+
+
+class TestPersonalRecords:
+    """Test TrainingLog.personal_records() detection."""
+
+    def _session(self, d, name, sets):
+        return TrainingSession(
+            date=d,
+            flag="*",
+            name="Session",
+            movements=(Movement(name, sets, None),),
+        )
+
+    def test_max_weight_and_date(self):
+        log = TrainingLog(
+            sessions=(
+                self._session(
+                    date(2025, 1, 10), "squat", [TrainingSet(5, 100 * ureg.kg)]
+                ),
+                self._session(
+                    date(2025, 1, 17), "squat", [TrainingSet(3, 110 * ureg.kg)]
+                ),
+            )
+        )
+        pr = log.personal_records()["squat"]
+        assert pr.max_weight == 110 * ureg.kg
+        assert pr.max_weight_date == date(2025, 1, 17)
+        assert pr.max_weight_set.reps == 3
+
+    def test_tie_resolves_to_earliest_date(self):
+        log = TrainingLog(
+            sessions=(
+                self._session(
+                    date(2025, 1, 10), "squat", [TrainingSet(5, 100 * ureg.kg)]
+                ),
+                self._session(
+                    date(2025, 1, 17), "squat", [TrainingSet(5, 100 * ureg.kg)]
+                ),
+            )
+        )
+        pr = log.personal_records()["squat"]
+        assert pr.max_weight_date == date(2025, 1, 10)
+
+    def test_best_e1rm_can_come_from_rep_set(self):
+        # 100kg x 5 (Brzycki e1rm 112.5) beats a 105kg single
+        log = TrainingLog(
+            sessions=(
+                self._session(
+                    date(2025, 1, 10), "squat", [TrainingSet(1, 105 * ureg.kg)]
+                ),
+                self._session(
+                    date(2025, 1, 17), "squat", [TrainingSet(5, 100 * ureg.kg)]
+                ),
+            )
+        )
+        pr = log.personal_records()["squat"]
+        assert pr.max_weight == 105 * ureg.kg
+        assert pr.best_e1rm_kg == pytest.approx(112.5)
+        assert pr.best_e1rm_date == date(2025, 1, 17)
+
+    def test_units_compared_canonically(self):
+        # 225lb (~102kg) is heavier than 100kg
+        log = TrainingLog(
+            sessions=(
+                self._session(
+                    date(2025, 1, 10), "squat", [TrainingSet(5, 100 * ureg.kg)]
+                ),
+                self._session(
+                    date(2025, 1, 17), "squat", [TrainingSet(5, 225 * ureg.pound)]
+                ),
+            )
+        )
+        pr = log.personal_records()["squat"]
+        assert pr.max_weight == 225 * ureg.pound
+
+    def test_bodyweight_movement_reported_by_reps(self):
+        log = TrainingLog(
+            sessions=(
+                self._session(date(2025, 1, 10), "pullups", [TrainingSet(10, None)]),
+                self._session(date(2025, 1, 17), "pullups", [TrainingSet(14, None)]),
+            )
+        )
+        pr = log.personal_records()["pullups"]
+        assert pr.max_weight is None
+        assert pr.best_e1rm_kg is None
+        assert pr.max_reps == 14
+        assert pr.max_reps_date == date(2025, 1, 17)
+
+    def test_planned_sessions_excluded(self):
+        planned = TrainingSession(
+            date=date(2025, 1, 20),
+            flag="!",
+            name="Plan",
+            movements=(Movement("squat", [TrainingSet(1, 200 * ureg.kg)], None),),
+        )
+        log = TrainingLog(
+            sessions=(
+                self._session(
+                    date(2025, 1, 10), "squat", [TrainingSet(5, 100 * ureg.kg)]
+                ),
+                planned,
+            )
+        )
+        pr = log.personal_records()["squat"]
+        assert pr.max_weight == 100 * ureg.kg
+
+
+class TestWriteCsv:
+    """Test TrainingLog.write_csv() export."""
+
+    HEADER = "date,movement,weight_kg,sets,reps,rpe,rir,tags"
+
+    @pytest.fixture
+    def csv_log(self):
+        from ox.data import MovementDefinition
+
+        later = TrainingSession(
+            date=date(2025, 1, 12),
+            flag="*",
+            name="Pull Day",
+            movements=(
+                Movement("deadlift", [TrainingSet(5, 140 * ureg.kg, rpe=8.0)], None),
+            ),
+        )
+        earlier = TrainingSession(
+            date=date(2025, 1, 10),
+            flag="*",
+            name="Push Day",
+            movements=(
+                Movement(
+                    "bench-press",
+                    [TrainingSet(5, 100 * ureg.kg), TrainingSet(5, 100 * ureg.kg)],
+                    None,
+                ),
+                Movement("pullups", [TrainingSet(10)], None),
+            ),
+        )
+        return TrainingLog(
+            sessions=(later, earlier),
+            movement_definitions=(
+                MovementDefinition(name="deadlift", tags=("hip-hinge", "lower")),
+            ),
+        )
+
+    def _rows(self, log):
+        import io
+
+        buf = io.StringIO()
+        log.write_csv(buf)
+        return buf.getvalue().splitlines()
+
+    def test_header_is_stable(self, csv_log):
+        assert self._rows(csv_log)[0] == self.HEADER
+
+    def test_one_row_per_set(self, csv_log):
+        assert len(self._rows(csv_log)) == 1 + 4
+
+    def test_sorted_by_date(self, csv_log):
+        dates = [r.split(",")[0] for r in self._rows(csv_log)[1:]]
+        assert dates == sorted(dates)
+
+    def test_set_numbering_and_values(self, csv_log):
+        rows = self._rows(csv_log)
+        assert rows[1] == "2025-01-10,bench-press,100,1,5,,,"
+        assert rows[2] == "2025-01-10,bench-press,100,2,5,,,"
+
+    def test_optional_fields_blank(self, csv_log):
+        bodyweight = self._rows(csv_log)[3]
+        assert bodyweight == "2025-01-10,pullups,,1,10,,,"
+
+    def test_tags_semicolon_joined(self, csv_log):
+        deadlift = self._rows(csv_log)[4]
+        assert deadlift.endswith("hip-hinge;lower")
+        assert ",8," in deadlift  # rpe
+
+
+class TestResolveMovement:
+    def _log(self):
+        from ox.data import MovementDefinition
+
+        sessions = (
+            TrainingSession(
+                date=date(2025, 1, 10),
+                flag="*",
+                name="Session",
+                movements=(
+                    Movement("romanian-deadlift", [TrainingSet(5)], None),
+                    Movement("bench-press", [TrainingSet(5)], None),
+                ),
+            ),
+        )
+        return TrainingLog(
+            sessions=sessions,
+            movement_definitions=(MovementDefinition(name="romanian-deadlift"),),
+        )
+
+    def test_exact_match_full_confidence(self):
+        name, score = self._log().resolve_movement("bench-press")
+        assert name == "bench-press"
+        assert score == 1.0
+
+    def test_hyphen_and_case_insensitive(self):
+        name, score = self._log().resolve_movement("BenchPress")
+        assert name == "bench-press"
+        assert score == 1.0
+
+    def test_abbreviation_resolves(self):
+        name, score = self._log().resolve_movement("rdl")
+        assert name == "romanian-deadlift"
+        assert score >= 0.9
+
+    def test_near_miss_scores_below_one(self):
+        name, score = self._log().resolve_movement("romanian-dedlift")
+        assert name == "romanian-deadlift"
+        assert 0.8 < score < 1.0
+
+    def test_empty_log(self):
+        assert TrainingLog(sessions=()).resolve_movement("squat") == ("squat", 0.0)
+
+
+class TestFindInconsistentNames:
+    def _log(self, *names):
+        sessions = tuple(
+            TrainingSession(
+                date=date(2025, 1, 10),
+                flag="*",
+                name="Session",
+                movements=(Movement(n, [TrainingSet(5)], None),),
+            )
+            for n in names
+        )
+        return TrainingLog(sessions=sessions)
+
+    def test_case_and_hyphen_variants_clustered(self):
+        log = self._log("bench-press", "Bench-Press", "benchpress", "squat")
+        assert log.find_inconsistent_names() == [
+            ["Bench-Press", "bench-press", "benchpress"]
+        ]
+
+    def test_distinct_names_not_clustered(self):
+        log = self._log("squat", "deadlift", "bench-press")
+        assert log.find_inconsistent_names() == []
+
+    def test_typo_clustered(self):
+        log = self._log("romanian-deadlift", "romanian-dedlift")
+        assert log.find_inconsistent_names() == [
+            ["romanian-deadlift", "romanian-dedlift"]
+        ]
+
+
+class TestCoalesce:
+    def _session(self, movements):
+        return TrainingSession(
+            date=date(2025, 1, 10), flag="*", name="Day", movements=tuple(movements)
+        )
+
+    def _movement(self, name, kg, n_sets, note=None):
+        weight = kg * ureg.kg if kg is not None else None
+        return Movement(name, [TrainingSet(5, weight)] * n_sets, note)
+
+    def test_merges_consecutive_same_weight_lines(self):
+        log = TrainingLog(
+            sessions=(
+                self._session(
+                    [
+                        self._movement("bench-press", 80, 3),
+                        self._movement("bench-press", 80, 2),
+                    ]
+                ),
+            )
+        )
+        merged, count = log.coalesce()
+        assert count == 1
+        movements = merged.sessions[0].movements
+        assert len(movements) == 1
+        assert len(movements[0].sets) == 5
+
+    def test_distinct_weights_stay_separate(self):
+        # Top set + back-offs logged deliberately
+        log = TrainingLog(
+            sessions=(
+                self._session(
+                    [
+                        self._movement("squat", 140, 1),
+                        self._movement("squat", 120, 4),
+                    ]
+                ),
+            )
+        )
+        merged, count = log.coalesce()
+        assert count == 0
+        assert len(merged.sessions[0].movements) == 2
+
+    def test_non_consecutive_lines_not_merged(self):
+        log = TrainingLog(
+            sessions=(
+                self._session(
+                    [
+                        self._movement("squat", 100, 3),
+                        self._movement("bench-press", 80, 3),
+                        self._movement("squat", 100, 2),
+                    ]
+                ),
+            )
+        )
+        _, count = log.coalesce()
+        assert count == 0
+
+    def test_bodyweight_lines_merge(self):
+        log = TrainingLog(
+            sessions=(
+                self._session(
+                    [
+                        self._movement("pullups", None, 3),
+                        self._movement("pullups", None, 2),
+                    ]
+                ),
+            )
+        )
+        merged, count = log.coalesce()
+        assert count == 1
+        assert len(merged.sessions[0].movements[0].sets) == 5
+
+    def test_notes_combined_without_duplicates(self):
+        log = TrainingLog(
+            sessions=(
+                self._session(
+                    [
+                        self._movement("bench-press", 80, 3, note="paused"),
+                        self._movement("bench-press", 80, 2, note="felt heavy"),
+                    ]
+                ),
+            )
+        )
+        merged, _ = log.coalesce()
+        assert merged.sessions[0].movements[0].note == "paused; felt heavy"
+
+    def test_original_log_unchanged(self):
+        log = TrainingLog(
+            sessions=(
+                self._session(
+                    [
+                        self._movement("bench-press", 80, 3),
+                        self._movement("bench-press", 80, 2),
+                    ]
+                ),
+            )
+        )
+        log.coalesce()
+        assert len(log.sessions[0].movements) == 2
+
+
+class TestWarmupExclusion:
+    def _log(self):
+        session = TrainingSession(
+            date=date(2025, 1, 10),
+            flag="*",
+            name="Day",
+            movements=(
+                Movement(
+                    "bench-press",
+                    [
+                        TrainingSet(5, 100 * ureg.kg, warmup=True),
+                        TrainingSet(5, 80 * ureg.kg),
+                    ],
+                    None,
+                ),
+            ),
+        )
+        return TrainingLog(sessions=(session,))
+
+    def test_warmup_sets_never_set_records(self):
+        records = self._log().personal_records()
+        pr = records["bench-press"]
+        assert pr.max_weight.magnitude == 80
+
+
+class TestBestAtReps:
+    def _log(self, sets_by_date, flag="*"):
+        sessions = tuple(
+            TrainingSession(
+                date=d,
+                flag=flag,
+                name="Session",
+                movements=(Movement("squat", sets, None),),
+            )
+            for d, sets in sets_by_date
+        )
+        return TrainingLog(sessions=sessions)
+
+    def test_heaviest_at_exact_reps(self):
+        log = self._log(
+            [
+                (date(2025, 1, 6), [TrainingSet(3, 140 * ureg.kg)]),
+                (date(2025, 1, 13), [TrainingSet(3, 150 * ureg.kg)]),
+                (date(2025, 1, 20), [TrainingSet(5, 160 * ureg.kg)]),
+            ]
+        )
+        day, best = log.best_at_reps("squat", 3)
+        assert day == date(2025, 1, 13)
+        assert best.weight == 150 * ureg.kg
+
+    def test_no_matching_set_returns_none(self):
+        log = self._log([(date(2025, 1, 6), [TrainingSet(5, 100 * ureg.kg)])])
+        assert log.best_at_reps("squat", 3) is None
+        assert log.best_at_reps("bench-press", 5) is None
+
+    def test_tie_keeps_earliest_date(self):
+        log = self._log(
+            [
+                (date(2025, 1, 6), [TrainingSet(3, 140 * ureg.kg)]),
+                (date(2025, 1, 13), [TrainingSet(3, 140 * ureg.kg)]),
+            ]
+        )
+        day, _ = log.best_at_reps("squat", 3)
+        assert day == date(2025, 1, 6)
+
+    def test_warmups_excluded(self):
+        log = self._log(
+            [
+                (
+                    date(2025, 1, 6),
+                    [
+                        TrainingSet(3, 180 * ureg.kg, warmup=True),
+                        TrainingSet(3, 140 * ureg.kg),
+                    ],
+                )
+            ]
+        )
+        _, best = log.best_at_reps("squat", 3)
+        assert best.weight == 140 * ureg.kg
+
+    def test_planned_sessions_excluded(self):
+        log = self._log([(date(2025, 1, 6), [TrainingSet(3, 140 * ureg.kg)])], flag="!")
+        assert log.best_at_reps("squat", 3) is None
+
+    def test_range_variant(self):
+        log = self._log(
+            [
+                (date(2025, 1, 6), [TrainingSet(2, 150 * ureg.kg)]),
+                (date(2025, 1, 13), [TrainingSet(4, 145 * ureg.kg)]),
+                (date(2025, 1, 20), [TrainingSet(6, 155 * ureg.kg)]),
+            ]
+        )
+        day, best = log.best_at_reps_range("squat", 2, 5)
+        assert day == date(2025, 1, 6)
+        assert best.weight == 150 * ureg.kg
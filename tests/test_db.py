@@ -184,6 +184,9 @@ class TestTrainingView:
             "reps",
             "weight_magnitude",
             "weight_unit",
+            "rpe",
+            "rir",
+            "warmup",
         ]
 
     def test_filter_by_movement_name(self, simple_db):
@@ -0,0 +1,103 @@
+"""Tests for the deload recommendation (analysis helper and plugin)."""
+
+from datetime import date, timedelta
+
+from ox.analysis import DeloadOptions, recommend_deload
+from ox.builtins.deload import deload, register
+from ox.data import Movement, TrainingLog, TrainingSession, TrainingSet
+from ox.plugins import PluginContext, TextResult
+from ox.units import ureg
+
+START = date(2025, 1, 5)  # a Sunday
+
+
+def _week_session(week, weight, n_sets, rpe=None):
+    sets = [TrainingSet(5, weight * ureg.kg, rpe=rpe) for _ in range(n_sets)]
+    return TrainingSession(
+        date=START + timedelta(weeks=week),
+        flag="*",
+        name="Session",
+        movements=(Movement("squat", sets, None),),
+    )
+
+
+def _log(
+    baseline_sets=5,
+    recent_sets=5,
+    recent_weight=100,
+    baseline_rpe=None,
+    recent_rpe=None,
+):
+    """Four baseline weeks then four recent weeks of squat sessions."""
+    sessions = tuple(
+        _week_session(i, 100, baseline_sets, baseline_rpe) for i in range(4)
+    ) + tuple(
+        _week_session(i, recent_weight, recent_sets, recent_rpe) for i in range(4, 8)
+    )
+    return TrainingLog(sessions=sessions)
+
+
+class TestRecommendDeload:
+    def test_fatigued_log_recommends(self):
+        # Tonnage up 40%, same top weight, RPE climbing a full point
+        log = _log(recent_sets=7, baseline_rpe=7.0, recent_rpe=8.0)
+        recommended, reason = recommend_deload(log)
+        assert recommended
+        assert "Deload advisable" in reason
+        assert "tonnage up" in reason
+
+    def test_progressing_lifter_not_flagged(self):
+        # Tonnage rises because the weights rose; that's progress, not fatigue
+        log = _log(recent_sets=7, recent_weight=110, baseline_rpe=7.0, recent_rpe=8.0)
+        recommended, reason = recommend_deload(log)
+        assert not recommended
+        assert "best e1RM up" in reason
+
+    def test_flat_rpe_not_flagged(self):
+        log = _log(recent_sets=7, baseline_rpe=7.0, recent_rpe=7.0)
+        recommended, _ = recommend_deload(log)
+        assert not recommended
+
+    def test_missing_rpe_falls_back_with_note(self):
+        log = _log(recent_sets=7)
+        recommended, reason = recommend_deload(log)
+        assert recommended
+        assert "no RPE data" in reason
+
+    def test_thresholds_configurable(self):
+        log = _log(recent_sets=7, baseline_rpe=7.0, recent_rpe=8.0)
+        recommended, _ = recommend_deload(log, DeloadOptions(tonnage_rise=2.0))
+        assert not recommended
+
+    def test_short_history_declines(self):
+        log = TrainingLog(sessions=tuple(_week_session(i, 100, 5) for i in range(3)))
+        recommended, reason = recommend_deload(log)
+        assert not recommended
+        assert "Not enough history" in reason
+
+    def test_empty_log(self):
+        recommended, reason = recommend_deload(TrainingLog(sessions=()))
+        assert not recommended
+        assert "No completed sessions" in reason
+
+
+class TestDeloadPlugin:
+    def test_returns_text_result(self):
+        log = _log(recent_sets=7, baseline_rpe=7.0, recent_rpe=8.0)
+        result = deload(PluginContext(db=None, log=log))
+        assert isinstance(result, TextResult)
+        assert "Deload advisable" in result.text
+
+    def test_threshold_params_forwarded(self):
+        log = _log(recent_sets=7, baseline_rpe=7.0, recent_rpe=8.0)
+        result = deload(PluginContext(db=None, log=log), rise=2.0)
+        assert "No deload needed" in result.text
+
+    def test_register_returns_descriptor(self):
+        descriptors = register()
+        assert len(descriptors) == 1
+        desc = descriptors[0]
+        assert desc["name"] == "deload"
+        assert desc["fn"] is deload
+        param_names = {p["name"] for p in desc["params"]}
+        assert param_names == {"window", "rise", "gain", "rpe"}
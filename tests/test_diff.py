@@ -0,0 +1,159 @@
+"""Tests for plan-vs-actual session diffing."""
+
+from datetime import date
+from unittest.mock import patch
+
+from click.testing import CliRunner
+
+from ox.analysis import plan_vs_actual
+from ox.cli import cli
+from ox.data import Movement, TrainingSession, TrainingSet
+from ox.units import ureg
+
+
+def _session(flag, movements, day=date(2025, 1, 10)):
+    return TrainingSession(date=day, flag=flag, name="Upper", movements=movements)
+
+
+def _movement(name, weight_kg, sets, reps=5):
+    return Movement(name, [TrainingSet(reps, weight_kg * ureg.kg)] * sets, None)
+
+
+class TestPlanVsActual:
+    def test_matched_movement_no_deviation(self):
+        planned = _session("!", (_movement("squat", 100, 5),))
+        actual = _session("*", (_movement("squat", 100, 5),))
+        diff = plan_vs_actual(planned, actual)
+        assert diff.date == date(2025, 1, 10)
+        squat = diff.movements[0]
+        assert squat.planned_sets == 5
+        assert squat.actual_sets == 5
+        assert squat.missed_sets == 0
+        assert squat.extra_sets == 0
+        assert squat.weight_delta_kg == 0.0
+
+    def test_missed_and_extra_sets(self):
+        planned = _session(
+            "!", (_movement("squat", 100, 5), _movement("bench-press", 60, 3))
+        )
+        actual = _session(
+            "*", (_movement("squat", 100, 3), _movement("bench-press", 60, 5))
+        )
+        diff = plan_vs_actual(planned, actual)
+        by_name = {m.movement: m for m in diff.movements}
+        assert by_name["squat"].missed_sets == 2
+        assert by_name["squat"].extra_sets == 0
+        assert by_name["bench-press"].extra_sets == 2
+        assert by_name["bench-press"].missed_sets == 0
+
+    def test_weight_deviation(self):
+        planned = _session("!", (_movement("squat", 100, 5),))
+        actual = _session("*", (_movement("squat", 102.5, 5),))
+        diff = plan_vs_actual(planned, actual)
+        assert diff.movements[0].weight_delta_kg == 2.5
+
+    def test_reordered_movements_match(self):
+        planned = _session(
+            "!", (_movement("squat", 100, 5), _movement("bench-press", 60, 5))
+        )
+        actual = _session(
+            "*", (_movement("bench-press", 60, 5), _movement("squat", 100, 5))
+        )
+        diff = plan_vs_actual(planned, actual)
+        assert diff.missed_movements == ()
+        assert diff.extra_movements == ()
+        assert len(diff.movements) == 2
+
+    def test_missed_and_extra_movements(self):
+        planned = _session("!", (_movement("squat", 100, 5), _movement("ohp", 40, 3)))
+        actual = _session("*", (_movement("squat", 100, 5), _movement("curls", 20, 3)))
+        diff = plan_vs_actual(planned, actual)
+        assert diff.missed_movements == ("ohp",)
+        assert diff.extra_movements == ("curls",)
+
+    def test_repeated_movement_pooled(self):
+        planned = _session("!", (_movement("squat", 100, 3),))
+        actual = _session("*", (_movement("squat", 100, 2), _movement("squat", 100, 2)))
+        diff = plan_vs_actual(planned, actual)
+        assert diff.movements[0].actual_sets == 4
+        assert diff.movements[0].extra_sets == 1
+
+    def test_no_weighted_sets_delta_none(self):
+        planned = _session(
+            "!", (Movement("pullups", [TrainingSet(10, None)] * 3, None),)
+        )
+        actual = _session(
+            "*", (Movement("pullups", [TrainingSet(10, None)] * 3, None),)
+        )
+        diff = plan_vs_actual(planned, actual)
+        assert diff.movements[0].weight_delta_kg is None
+
+
+class TestDiffResultText:
+    def test_to_text_reports_deviations(self):
+        planned = _session("!", (_movement("squat", 100, 5), _movement("ohp", 40, 3)))
+        actual = _session("*", (_movement("squat", 102.5, 4),))
+        text = plan_vs_actual(planned, actual).to_text()
+        assert "Plan vs actual for 2025-01-10" in text
+        assert "squat: 4/5 sets, 1 missed, weight +2.5kg" in text
+        assert "Missed entirely: ohp" in text
+
+    def test_to_text_empty_sessions(self):
+        diff = plan_vs_actual(_session("!", ()), _session("*", ()))
+        assert "(nothing to compare)" in diff.to_text()
+
+
+def _invoke_repl(file_path, commands: list[str]):
+    """Invoke the CLI REPL with a sequence of commands, mocking prompt_toolkit."""
+    runner = CliRunner()
+    cmd_iter = iter(commands + ["exit"])
+
+    def mock_prompt(_self, *args, **kwargs):
+        return next(cmd_iter)
+
+    with patch("prompt_toolkit.PromptSession.prompt", mock_prompt):
+        return runner.invoke(cli, [str(file_path)])
+
+
+PAIRED_LOG = """\
+@session
+2025-01-10 ! Upper
+bench-press: 100kg 5x5
+@end
+
+@session
+2025-01-10 * Upper
+bench-press: 102.5kg 4x5
+@end
+"""
+
+
+class TestDiffCommand:
+    def test_diff_with_date(self, tmp_path):
+        f = tmp_path / "log.ox"
+        f.write_text(PAIRED_LOG)
+        result = _invoke_repl(f, ["diff 2025-01-10"])
+        assert result.exit_code == 0
+        assert "Plan vs actual for 2025-01-10" in result.output
+        assert "bench-press" in result.output
+
+    def test_diff_without_date_finds_paired_dates(self, tmp_path):
+        f = tmp_path / "log.ox"
+        f.write_text(PAIRED_LOG)
+        result = _invoke_repl(f, ["diff"])
+        assert result.exit_code == 0
+        assert "Plan vs actual for 2025-01-10" in result.output
+
+    def test_diff_no_planned_session(self, tmp_path):
+        f = tmp_path / "log.ox"
+        f.write_text("2025-01-10 * squat: 100kg 5x5\n")
+        result = _invoke_repl(f, ["diff 2025-01-10"])
+        assert result.exit_code == 0
+        assert "No planned session on 2025-01-10" in result.output
+
+    def test_diff_bad_date(self, tmp_path):
+        f = tmp_path / "log.ox"
+        f.write_text(PAIRED_LOG)
+        result = _invoke_repl(f, ["diff yesterday"])
+        assert result.exit_code == 0
+        assert "Usage: diff" in result.output
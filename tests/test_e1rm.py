@@ -0,0 +1,123 @@
+"""Tests for the e1rm plugin and 1RM estimation formulas."""
+
+import pytest
+
+from ox.builtins.e1rm import (
+    FORMULAS,
+    MAX_RELIABLE_REPS,
+    estimated_1rm,
+    one_rep_max,
+    one_rep_max_all,
+)
+from ox.cli import parse_file
+from ox.data import TrainingLog
+from ox.db import create_db
+from ox.plugins import PluginContext, TableResult
+
+
+@pytest.fixture
+def rm_db(tmp_path):
+    """In-memory DB with max-effort (^rm) sets for one movement."""
+    content = (
+        '2025-01-10 * deadlift: 315lb 1x3 "^rm"\n'
+        '2025-01-17 * deadlift: 325lb 1x2 "^rm"\n'
+        "2025-01-18 * deadlift: 225lb 5x5\n"
+    )
+    f = tmp_path / "rm.ox"
+    f.write_text(content)
+    log = parse_file(f)
+    conn = create_db(log)
+    yield conn
+    conn.close()
+
+
+class TestFormulas:
+    """Test the individual estimation formulas."""
+
+    def test_brzycki(self):
+        assert one_rep_max(100, 5, "brzycki") == pytest.approx(112.5)
+
+    def test_epley(self):
+        assert one_rep_max(100, 5, "epley") == pytest.approx(116.667, abs=0.001)
+
+    def test_lombardi(self):
+        assert one_rep_max(100, 5, "lombardi") == pytest.approx(117.462, abs=0.001)
+
+    def test_lander(self):
+        assert one_rep_max(100, 5, "lander") == pytest.approx(113.709, abs=0.001)
+
+    def test_single_rep_brzycki_is_identity(self):
+        assert one_rep_max(315, 1, "brzycki") == pytest.approx(315)
+
+
+class TestOneRepMax:
+    """Test validation and clamping in one_rep_max."""
+
+    def test_unknown_formula_raises(self):
+        with pytest.raises(ValueError, match="Unknown formula"):
+            one_rep_max(100, 5, "nonsense")
+
+    def test_zero_weight_raises(self):
+        with pytest.raises(ValueError, match="weight must be positive"):
+            one_rep_max(0, 5)
+
+    def test_negative_weight_raises(self):
+        with pytest.raises(ValueError, match="weight must be positive"):
+            one_rep_max(-100, 5)
+
+    def test_zero_reps_raises(self):
+        with pytest.raises(ValueError, match="reps must be >= 1"):
+            one_rep_max(100, 0)
+
+    def test_high_reps_clamped(self):
+        assert one_rep_max(100, 20) == one_rep_max(100, MAX_RELIABLE_REPS)
+
+    def test_all_returns_every_formula(self):
+        estimates = one_rep_max_all(100, 5)
+        assert set(estimates) == set(FORMULAS)
+        assert all(v > 100 for v in estimates.values())
+
+
+class TestEstimated1RMPlugin:
+    """Test the e1rm plugin report."""
+
+    def _run(self, db, **kwargs):
+        ctx = PluginContext(db=db, log=TrainingLog(sessions=()))
+        return estimated_1rm(ctx, **kwargs)
+
+    def test_table_columns(self, rm_db):
+        result = self._run(rm_db, movement="deadlift")
+        assert isinstance(result, TableResult)
+        assert result.columns == [
+            "date",
+            "estimated_1rm (lb)",
+            "weight (lb)",
+            "reps",
+        ]
+        assert len(result.rows) == 2
+
+    def test_formula_selection(self, rm_db):
+        brzycki = self._run(rm_db, movement="deadlift", formula="brzycki")
+        lander = self._run(rm_db, movement="deadlift", formula="lander")
+        assert brzycki.rows[0][1] != lander.rows[0][1]
+
+    def test_formula_all_columns(self, rm_db):
+        result = self._run(rm_db, movement="deadlift", formula="all")
+        assert result.columns == [
+            "date",
+            "brzycki (lb)",
+            "epley (lb)",
+            "lombardi (lb)",
+            "lander (lb)",
+            "weight (lb)",
+            "reps",
+        ]
+        assert len(result.rows) == 2
+
+    def test_formula_all_rejects_plot(self, rm_db):
+        with pytest.raises(ValueError, match="only supports table"):
+            self._run(rm_db, movement="deadlift", formula="all", output="plot")
+
+    def test_unknown_formula_raises(self, rm_db):
+        with pytest.raises(ValueError, match="Unknown formula"):
+            self._run(rm_db, movement="deadlift", formula="oconner")
@@ -0,0 +1,71 @@
+"""Tests for the .ox formatter."""
+
+from ox.format import format_source
+
+MESSY = """\
+# morning  session
+@session
+2025-01-11   *   Upper Day
+Bench_Press:   135lb   5x5
+squat: 100kg 5x5   # felt heavy
+
+kb-oh-press: 24kg 5/5/5
+@end
+
+2025-01-10  *  Pull_Ups:  BW 5x10
+"""
+
+
+class TestFormatSource:
+    def test_collapses_spacing(self):
+        out = format_source("2025-01-10   *   pullups:   BW   5x10\n")
+        assert out == "2025-01-10 * pullups: BW 5x10\n"
+
+    def test_normalizes_movement_names(self):
+        out = format_source(MESSY)
+        assert "bench-press:" in out
+        assert "Bench_Press" not in out
+        assert "pull-ups:" in out
+
+    def test_canonicalizes_units(self):
+        out = format_source("2025-01-10 * squat: 100kilogram 5x5\n")
+        assert "100kg" in out
+        out = format_source("2025-01-10 W 185pound\n")
+        assert "185lb" in out
+
+    def test_aligns_item_columns(self):
+        src = "@session\n2025-01-11 * Day\nsquat: 100kg 5x5\nbench-press: 60kg 5x5\n@end\n"
+        out = format_source(src)
+        lines = out.split("\n")
+        squat = next(line for line in lines if line.startswith("squat"))
+        bench = next(line for line in lines if line.startswith("bench"))
+        assert squat.index("100kg") == bench.index("60kg")
+
+    def test_preserves_comments(self):
+        out = format_source(MESSY)
+        assert "# morning  session" in out
+        assert "# felt heavy" in out
+
+    def test_preserves_blank_line_grouping(self):
+        out = format_source(MESSY)
+        assert "\n\nkb-oh-press" in out
+
+    def test_preserves_quoted_strings(self):
+        src = '2025-01-10 note "two  spaces # not a comment"\n'
+        assert format_source(src) == src
+
+    def test_idempotent(self):
+        once = format_source(MESSY)
+        assert format_source(once) == once
+
+    def test_terminates_with_newline(self):
+        assert format_source("2025-01-10 * squat: 100kg 5x5").endswith("5x5\n")
+
+    def test_output_still_parses(self, tmp_path):
+        from ox.cli import parse_file
+
+        f = tmp_path / "fmt.ox"
+        f.write_text(format_source(MESSY))
+        log = parse_file(f)
+        assert not any(d.severity == "error" for d in log.diagnostics)
+        assert len(log.sessions) == 2
@@ -0,0 +1,127 @@
+"""Tests for @goal directives and goal progress projection."""
+
+from datetime import date
+
+from ox.analysis import goal_progress
+from ox.builtins.goals import goals as goals_plugin
+from ox.data import Goal, Movement, TrainingLog, TrainingSession, TrainingSet
+from ox.plugins import PluginContext, TableResult
+from ox.serialize import from_json, to_json
+from ox.units import ureg
+
+
+def _session(day, weight_kg):
+    # 1-rep sets so e1RM equals the weight exactly
+    return TrainingSession(
+        date=day,
+        flag="*",
+        name="Day",
+        movements=(Movement("squat", [TrainingSet(1, weight_kg * ureg.kg)], None),),
+    )
+
+
+def _log(weights_by_date, goal_kg):
+    return TrainingLog(
+        sessions=tuple(_session(d, w) for d, w in weights_by_date),
+        goals=(Goal(movement="squat", target=goal_kg * ureg.kg),),
+    )
+
+
+class TestGoalDirective:
+    def test_parses_goal_directive(self, tmp_path):
+        from ox.cli import parse_file
+
+        f = tmp_path / "goal.ox"
+        f.write_text("@goal squat 180kg\n2025-01-06 * squat: 100kg 5x5\n")
+        log = parse_file(f)
+        assert log.diagnostics == ()
+        assert len(log.goals) == 1
+        assert log.goals[0].movement == "squat"
+        assert log.goals[0].target == 180 * ureg.kg
+        assert log.goals[0].target_kg == 180.0
+
+    def test_goal_to_ox_round_trip(self):
+        goal = Goal(movement="squat", target=180 * ureg.kg)
+        assert goal.to_ox() == "@goal squat 180kg"
+
+    def test_goal_json_round_trip(self):
+        log = _log([(date(2025, 1, 6), 100)], 180)
+        assert from_json(to_json(log)).goals == log.goals
+
+
+class TestGoalProgress:
+    def test_achieved_records_first_date(self):
+        log = _log(
+            [
+                (date(2025, 1, 6), 100),
+                (date(2025, 1, 13), 105),
+                (date(2025, 1, 20), 110),
+            ],
+            105,
+        )
+        (status,) = goal_progress(log)
+        assert status.achieved
+        assert status.achieved_date == date(2025, 1, 13)
+        assert status.gap_kg == 0.0
+        assert status.on_track
+        assert status.projected_date is None
+
+    def test_projects_date_from_trend(self):
+        # +5 kg/week; 10 kg remaining projects two weeks past the last point
+        log = _log(
+            [
+                (date(2025, 1, 6), 100),
+                (date(2025, 1, 13), 105),
+                (date(2025, 1, 20), 110),
+            ],
+            120,
+        )
+        (status,) = goal_progress(log)
+        assert not status.achieved
+        assert status.best_kg == 110.0
+        assert status.gap_kg == 10.0
+        assert status.on_track
+        assert status.projected_date == date(2025, 2, 3)
+
+    def test_flat_trend_not_on_track(self):
+        log = _log(
+            [
+                (date(2025, 1, 6), 100),
+                (date(2025, 1, 13), 100),
+                (date(2025, 1, 20), 100),
+            ],
+            120,
+        )
+        (status,) = goal_progress(log)
+        assert not status.on_track
+        assert status.projected_date is None
+
+    def test_too_little_history_not_on_track(self):
+        log = _log([(date(2025, 1, 6), 100), (date(2025, 1, 13), 105)], 120)
+        (status,) = goal_progress(log)
+        assert not status.on_track
+        assert status.projected_date is None
+
+    def test_untrained_movement(self):
+        log = TrainingLog(
+            sessions=(),
+            goals=(Goal(movement="deadlift", target=200 * ureg.kg),),
+        )
+        (status,) = goal_progress(log)
+        assert status.best_kg is None
+        assert status.gap_kg == 200.0
+        assert not status.on_track
+
+
+class TestGoalsPlugin:
+    def test_returns_table(self):
+        log = _log([(date(2025, 1, 6), 100)], 180)
+        result = goals_plugin(PluginContext(db=None, log=log))
+        assert isinstance(result, TableResult)
+        assert result.rows[0][0] == "squat"
+        assert result.rows[0][4] == "not on track"
+
+    def test_no_goals_notes_empty(self):
+        result = goals_plugin(PluginContext(db=None, log=TrainingLog(sessions=())))
+        assert result.rows == []
+        assert result.note is not None
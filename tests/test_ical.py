@@ -0,0 +1,70 @@
+"""Tests for iCalendar export."""
+
+import io
+from datetime import date
+
+from ox.data import Movement, TrainingSession, TrainingSet
+from ox.ical import to_ics, write_ics
+from ox.units import ureg
+
+
+def _session(name="Upper Day", day=10):
+    return TrainingSession(
+        date=date(2025, 1, day),
+        flag="!",
+        name=name,
+        movements=(
+            Movement("bench-press", [TrainingSet(5, 100 * ureg.kg)] * 5, None),
+            Movement("pullups", [TrainingSet(10, bodyweight=True)] * 3, None),
+        ),
+    )
+
+
+class TestToIcs:
+    def test_one_vevent_per_session(self):
+        ics = to_ics([_session(day=10), _session(day=13)])
+        assert ics.count("BEGIN:VEVENT") == 2
+        assert ics.count("END:VEVENT") == 2
+        assert ics.startswith("BEGIN:VCALENDAR\r\n")
+        assert ics.rstrip("\r\n").endswith("END:VCALENDAR")
+
+    def test_all_day_event_date(self):
+        ics = to_ics([_session(day=10)])
+        assert "DTSTART;VALUE=DATE:20250110" in ics
+
+    def test_summary_lists_movements(self):
+        ics = to_ics([_session()])
+        assert "SUMMARY:Upper Day: bench-press\\, pullups" in ics
+
+    def test_description_has_set_details(self):
+        ics = to_ics([_session()])
+        assert "bench-press: 100kg 5x5" in ics.replace("\r\n ", "")
+
+    def test_uid_is_deterministic(self):
+        assert to_ics([_session()]) == to_ics([_session()])
+        assert "UID:20250110-0-upper-day@ox" in to_ics([_session()])
+
+    def test_single_line_entry_summary_falls_back_to_movements(self):
+        s = TrainingSession(
+            date=date(2025, 1, 10),
+            flag="*",
+            name=None,
+            movements=(Movement("squat", [TrainingSet(5, 100 * ureg.kg)], None),),
+        )
+        assert "SUMMARY:squat" in to_ics([s])
+
+    def test_lines_folded_to_75_octets(self):
+        s = TrainingSession(
+            date=date(2025, 1, 10),
+            flag="!",
+            name="A" * 120,
+            movements=(),
+        )
+        ics = to_ics([s])
+        for line in ics.split("\r\n"):
+            assert len(line.encode("utf-8")) <= 75
+
+    def test_write_ics_matches_to_ics(self):
+        buf = io.StringIO()
+        write_ics([_session()], buf)
+        assert buf.getvalue() == to_ics([_session()])
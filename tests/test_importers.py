@@ -0,0 +1,74 @@
+"""Tests for third-party export importers."""
+
+import io
+
+import pytest
+
+from ox.importers import ImportResult, import_strong_csv, slugify
+from ox.units import ureg
+
+STRONG_CSV = """Date,Workout Name,Exercise Name,Set Order,Weight (kg),Reps
+2023-01-10 17:30:00,Push Day,Bench Press (Barbell),1,60,8
+2023-01-10 17:30:00,Push Day,Bench Press (Barbell),2,60,8
+2023-01-10 17:30:00,Push Day,Overhead Press (Barbell),1,40,10
+2023-01-12 18:00:00,Pull Day,Pull Up,1,0,12
+"""
+
+
+class TestSlugify:
+    @pytest.mark.parametrize(
+        "name,slug",
+        [
+            ("Bench Press (Barbell)", "bench-press-barbell"),
+            ("Pull Up", "pull-up"),
+            ("Romanian Deadlift", "romanian-deadlift"),
+            ("21s Curl", "21s-curl"),
+        ],
+    )
+    def test_slugs(self, name, slug):
+        assert slugify(name) == slug
+
+
+class TestImportStrongCsv:
+    def _import(self, text=STRONG_CSV, **kwargs):
+        return import_strong_csv(io.StringIO(text), **kwargs)
+
+    def test_returns_import_result(self):
+        result = self._import()
+        assert isinstance(result, ImportResult)
+
+    def test_sessions_grouped_by_date_and_workout(self):
+        log = self._import().log
+        assert len(log.sessions) == 2
+        assert [s.name for s in log.sessions] == ["Push Day", "Pull Day"]
+
+    def test_sets_ordered_within_movement(self):
+        log = self._import().log
+        bench = log.sessions[0].movements[0]
+        assert bench.name == "bench-press-barbell"
+        assert len(bench.sets) == 2
+        assert all(s.reps == 8 for s in bench.sets)
+
+    def test_header_unit_respected(self):
+        log = self._import().log
+        assert log.sessions[0].movements[0].sets[0].weight == 60 * ureg.kg
+
+    def test_lb_header_unit(self):
+        text = STRONG_CSV.replace("Weight (kg)", "Weight (lb)")
+        log = self._import(text).log
+        assert log.sessions[0].movements[0].sets[0].weight == 60 * ureg.lb
+
+    def test_zero_weight_becomes_bodyweight(self):
+        log = self._import().log
+        pull_up = log.sessions[1].movements[0]
+        assert pull_up.sets[0].weight is None
+        assert pull_up.sets[0].reps == 12
+
+    def test_unmapped_exercises_collected_not_dropped(self):
+        result = self._import(known_movements={"bench-press-barbell", "pull-up"})
+        assert result.unmapped_exercises == ("Overhead Press (Barbell)",)
+        names = {m.name for s in result.log.sessions for m in s.movements}
+        assert "overhead-press-barbell" in names
+
+    def test_no_known_movements_means_nothing_unmapped(self):
+        assert self._import().unmapped_exercises == ()
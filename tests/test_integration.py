@@ -323,3 +323,60 @@ class TestEndToEndScenarios:
                     return
 
         pytest.fail("No bench-press movement found to test volume")
+
+
+class TestParseDir:
+    """parse_dir merges a directory of monthly files into one log."""
+
+    def _write(self, tmp_path, name, content):
+        f = tmp_path / name
+        f.write_text(content)
+        return f
+
+    def test_merged_and_sorted(self, tmp_path):
+        from ox.cli import parse_dir
+
+        self._write(tmp_path, "2025-02.ox", "2025-02-01 * squat: 110kg 5x5\n")
+        self._write(tmp_path, "2025-01.ox", "2025-01-10 * squat: 100kg 5x5\n")
+        log = parse_dir(tmp_path)
+        assert [s.date for s in log.sessions] == [date(2025, 1, 10), date(2025, 2, 1)]
+
+    def test_identical_sessions_deduplicated(self, tmp_path):
+        from ox.cli import parse_dir
+
+        entry = "2025-01-10 * squat: 100kg 5x5\n"
+        self._write(tmp_path, "a.ox", entry)
+        self._write(tmp_path, "b.ox", entry)
+        log = parse_dir(tmp_path)
+        assert len(log.sessions) == 1
+
+    def test_units_scoped_per_file(self, tmp_path):
+        from ox.cli import parse_dir
+        from ox.units import ureg
+
+        self._write(tmp_path, "a.ox", "@units lb\n2025-01-10 * squat: 100 5x5\n")
+        self._write(tmp_path, "b.ox", "2025-01-11 * squat: 100kg 5x5\n")
+        log = parse_dir(tmp_path)
+        assert log.sessions[0].movements[0].sets[0].weight == 100 * ureg.lb
+        assert log.sessions[1].movements[0].sets[0].weight == 100 * ureg.kg
+        assert log.default_unit is None
+
+    def test_diagnostics_name_their_file(self, tmp_path):
+        from ox.cli import parse_dir
+
+        self._write(tmp_path, "good.ox", "2025-01-10 * squat: 100kg 5x5\n")
+        self._write(tmp_path, "bad.ox", "2025-01-11 squat 100kg\n")
+        log = parse_dir(tmp_path)
+        assert any(d.message.startswith("bad.ox:") for d in log.diagnostics)
+
+    def test_missing_dir_raises(self, tmp_path):
+        from ox.cli import parse_dir
+
+        with pytest.raises(FileNotFoundError):
+            parse_dir(tmp_path / "nope")
+
+    def test_empty_dir_raises(self, tmp_path):
+        from ox.cli import parse_dir
+
+        with pytest.raises(ValueError, match="No .ox files"):
+            parse_dir(tmp_path)
@@ -5,7 +5,7 @@ from unittest.mock import patch
 from click.testing import CliRunner
 
 from ox.cli import cli, parse_file
-from ox.lint import collect_diagnostics
+from ox.lint import collect_diagnostics, collect_semantic_diagnostics
 from ox.data import Diagnostic
 
 from tree_sitter import Language, Parser
@@ -59,6 +59,121 @@ class TestCollectDiagnostics:
         assert collect_diagnostics(tree) == ()
 
 
+class TestDiagnosticSnippets:
+    def test_syntax_error_includes_offending_line(self):
+        text = "2025-01-10 * squat: 135lbs 5x5\n"
+        tree = _parse_tree(text)
+        diags = collect_diagnostics(tree)
+        assert diags
+        assert diags[0].snippet == "2025-01-10 * squat: 135lbs 5x5"
+
+    def test_semantic_error_includes_offending_line(self):
+        text = "2025-01-10 * squat: 100kg 5x3 @15\n"
+        tree = _parse_tree(text)
+        diags = collect_semantic_diagnostics(tree)
+        assert diags
+        assert diags[0].snippet == "2025-01-10 * squat: 100kg 5x3 @15"
+
+    def test_multiple_errors_each_have_snippets(self, tmp_path):
+        f = tmp_path / "two_bad.ox"
+        f.write_text(
+            "2025-01-10 * squat: 135lbs 5x5\n"
+            "2025-01-11 * bench: 100kg 5x5\n"
+            "2025-01-12 * press: 60kgs 5x5\n"
+        )
+        log = parse_file(f)
+        errors = [d for d in log.diagnostics if d.snippet]
+        assert len(errors) >= 2
+        assert all(d.snippet for d in errors)
+
+    def test_lint_command_shows_snippet(self, tmp_path):
+        f = tmp_path / "bad.ox"
+        f.write_text("2025-01-10 * squat: 135lbs 5x5\n")
+        runner = CliRunner()
+        with patch("ox.cli.PromptSession") as mock_session:
+            mock_session.return_value.prompt.side_effect = ["lint", "exit"]
+            result = runner.invoke(cli, [str(f)])
+        assert "135lbs" in result.output
+
+
+class TestSemanticDiagnostics:
+    def test_rpe_in_range_ok(self):
+        tree = _parse_tree("2025-01-10 * squat: 100kg 5x3 @7.5\n")
+        assert collect_semantic_diagnostics(tree) == ()
+
+    def test_rpe_out_of_range_flagged(self):
+        tree = _parse_tree("2025-01-10 * squat: 100kg 5x3 @12\n")
+        diags = collect_semantic_diagnostics(tree)
+        assert len(diags) == 1
+        assert "RPE must be between 1 and 10" in diags[0].message
+        assert diags[0].severity == "error"
+
+    def test_rir_not_range_checked(self):
+        tree = _parse_tree("2025-01-10 * squat: 100kg 5x3 @RIR4\n")
+        assert collect_semantic_diagnostics(tree) == ()
+
+    def test_iso_date_no_warning(self):
+        tree = _parse_tree("2025-01-10 * squat: 100kg 5x3\n")
+        assert collect_semantic_diagnostics(tree) == ()
+
+    def test_month_name_date_warned(self):
+        tree = _parse_tree("Mar 15 2024 * squat: 100kg 5x3\n")
+        diags = collect_semantic_diagnostics(tree)
+        assert len(diags) == 1
+        assert "prefer YYYY-MM-DD" in diags[0].message
+        assert diags[0].severity == "warning"
+
+    def test_semantic_errors_surface_in_log(self, tmp_path):
+        f = tmp_path / "bad_rpe.ox"
+        f.write_text("2025-01-10 * squat: 100kg 5x3 @0.5\n")
+        log = parse_file(f)
+        assert any("RPE" in d.message for d in log.diagnostics)
+
+
+class TestMovementDefinitionValidation:
+    def test_clean_definition_no_diagnostics(self):
+        tree = _parse_tree(
+            "@movement squat\nequipment: barbell\ntags: squat, lower\n@end\n"
+        )
+        assert collect_semantic_diagnostics(tree) == ()
+
+    def test_duplicate_definition_flagged(self):
+        tree = _parse_tree(
+            "@movement squat\ntags: lower\n@end\n@movement squat\ntags: squat\n@end\n"
+        )
+        diags = collect_semantic_diagnostics(tree)
+        assert len(diags) == 1
+        assert "Duplicate @movement definition 'squat'" in diags[0].message
+        assert diags[0].severity == "warning"
+
+    def test_unknown_field_flagged(self):
+        tree = _parse_tree("@movement squat\nequipmnt: barbell\n@end\n")
+        diags = collect_semantic_diagnostics(tree)
+        assert len(diags) == 1
+        assert "Unknown field 'equipmnt' in @movement squat" in diags[0].message
+
+    def test_empty_tag_flagged(self):
+        tree = _parse_tree("@movement squat\ntags: squat,, lower\n@end\n")
+        diags = collect_semantic_diagnostics(tree)
+        assert any("Empty tag in 'tags'" in d.message for d in diags)
+
+    def test_trailing_comma_flagged(self):
+        tree = _parse_tree("@movement squat\ntags: squat,\n@end\n")
+        diags = collect_semantic_diagnostics(tree)
+        assert any("Empty tag" in d.message for d in diags)
+
+    def test_quoted_tag_flagged(self):
+        tree = _parse_tree('@movement squat\ntags: "squat", lower\n@end\n')
+        diags = collect_semantic_diagnostics(tree)
+        assert any("should not be quoted" in d.message for d in diags)
+
+    def test_problems_surface_in_log(self, tmp_path):
+        f = tmp_path / "defs.ox"
+        f.write_text("@movement squat\nequipmnt: barbell\n@end\n")
+        log = parse_file(f)
+        assert any("Unknown field" in d.message for d in log.diagnostics)
+
+
 class TestTrainingLogDiagnostics:
     def test_parse_file_valid_log_no_diagnostics(self, simple_log_file):
         log = parse_file(simple_log_file)
@@ -191,3 +191,57 @@ def test_db_session_notes_fk(db_with_notes):
         "SELECT sn.text FROM session_notes sn JOIN sessions s ON sn.session_id = s.id"
     ).fetchall()
     assert rows[0][0] == "Cycle 1 Week 1"
+
+
+# ---------------------------------------------------------------------------
+# parse_file — comments and blank lines inside sessions
+# ---------------------------------------------------------------------------
+
+
+@pytest.fixture
+def log_with_comments(tmp_path):
+    content = """\
+@session
+2025-01-11 * Upper Day
+# felt strong today
+bench-press: 135lb 5x5 # paused last rep
+
+pullups: BW 5x10
+@end
+2025-01-12 * squat: 100kg 5x5 # tweaked back
+"""
+    f = tmp_path / "test.ox"
+    f.write_text(content)
+    return parse_file(f)
+
+
+def test_comments_parse_clean(log_with_comments):
+    assert log_with_comments.diagnostics == ()
+
+
+def test_session_comment_becomes_note(log_with_comments):
+    session = log_with_comments.sessions[0]
+    assert [n.text for n in session.notes] == ["felt strong today"]
+
+
+def test_inline_comment_becomes_movement_note(log_with_comments):
+    bench = log_with_comments.sessions[0].movements[0]
+    assert bench.note == "paused last rep"
+
+
+def test_inline_comment_on_singleline_entry(log_with_comments):
+    squat = log_with_comments.sessions[1].movements[0]
+    assert squat.note == "tweaked back"
+
+
+def test_blank_line_in_session_ignored(log_with_comments):
+    session = log_with_comments.sessions[0]
+    assert [m.name for m in session.movements] == ["bench-press", "pullups"]
+
+
+def test_inline_comment_joins_quoted_note(tmp_path):
+    content = '2025-01-12 * squat: 100kg 5x5 "belt on" # tweaked back\n'
+    f = tmp_path / "test.ox"
+    f.write_text(content)
+    log = parse_file(f)
+    assert log.sessions[0].movements[0].note == "belt on; tweaked back"
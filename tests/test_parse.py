@@ -8,7 +8,13 @@ Testing philosophy:
 
 import pytest
 
-from ox.parse import weight_text_to_quantity, process_weights
+from ox.parse import (
+    parse_date_text,
+    parse_rest_text,
+    parse_tempo_text,
+    weight_text_to_quantity,
+    process_weights,
+)
 from ox.units import ureg
 
 
@@ -166,6 +172,233 @@ def _parse_str(content: str):
     return tree, collect_diagnostics(tree)
 
 
+class TestEffortToken:
+    """Test RPE/RIR effort annotations after the rep scheme."""
+
+    @pytest.mark.parametrize("effort", ["@8", "@7.5", "@10", "@RIR2", "@rir3"])
+    def test_accepted(self, effort):
+        _, diags = _parse_str(f"2025-01-10 * squat: 100kg 5x3 {effort}\n")
+        assert not diags
+
+    def test_rpe_populated_on_sets(self, tmp_path):
+        from ox.cli import parse_file
+
+        f = tmp_path / "rpe.ox"
+        f.write_text("2025-01-10 * squat: 100kg 5x3 @7.5\n")
+        log = parse_file(f)
+        sets = log.sessions[0].movements[0].sets
+        assert all(s.rpe == 7.5 for s in sets)
+        assert all(s.rir is None for s in sets)
+
+    def test_rir_populated_on_sets(self, tmp_path):
+        from ox.cli import parse_file
+
+        f = tmp_path / "rir.ox"
+        f.write_text("2025-01-10 * bench-press: 80kg 5x5 @RIR2\n")
+        log = parse_file(f)
+        sets = log.sessions[0].movements[0].sets
+        assert all(s.rir == 2 for s in sets)
+        assert all(s.rpe is None for s in sets)
+
+    def test_absent_effort_is_none(self, tmp_path):
+        from ox.cli import parse_file
+
+        f = tmp_path / "plain.ox"
+        f.write_text("2025-01-10 * squat: 100kg 5x3\n")
+        log = parse_file(f)
+        sets = log.sessions[0].movements[0].sets
+        assert all(s.rpe is None and s.rir is None for s in sets)
+
+
+class TestBodyweight:
+    """Test bodyweight sets, BW+X added load, and the @bodyweight directive."""
+
+    def _parse(self, tmp_path, content):
+        from ox.cli import parse_file
+
+        f = tmp_path / "bw.ox"
+        f.write_text(content)
+        return parse_file(f)
+
+    def test_lowercase_bw_accepted(self, tmp_path):
+        log = self._parse(tmp_path, "2025-01-10 * pullup: bw 3x8\n")
+        assert log.diagnostics == ()
+        s = log.sessions[0].movements[0].sets[0]
+        assert s.bodyweight is True
+        assert s.weight is None
+
+    def test_added_load_recorded_without_directive(self, tmp_path):
+        log = self._parse(tmp_path, "2025-01-10 * pullup: BW+20kg 3x5\n")
+        s = log.sessions[0].movements[0].sets[0]
+        assert s.bodyweight is True
+        assert s.weight is None  # excluded from tonnage without @bodyweight
+        assert s.added_weight == 20 * ureg.kg
+
+    def test_directive_resolves_bodyweight(self, tmp_path):
+        log = self._parse(tmp_path, "@bodyweight 82kg\n2025-01-10 * pullup: BW 3x8\n")
+        assert log.bodyweight == 82 * ureg.kg
+        s = log.sessions[0].movements[0].sets[0]
+        assert s.weight == 82 * ureg.kg
+        assert s.bodyweight is True
+
+    def test_directive_resolves_added_load(self, tmp_path):
+        log = self._parse(
+            tmp_path, "@bodyweight 82kg\n2025-01-10 * pullup: BW+20kg 3x5\n"
+        )
+        s = log.sessions[0].movements[0].sets[0]
+        assert s.weight == 102 * ureg.kg
+        assert s.added_weight == 20 * ureg.kg
+
+    def test_plain_weight_not_bodyweight(self, tmp_path):
+        log = self._parse(tmp_path, "2025-01-10 * squat: 100kg 3x5\n")
+        s = log.sessions[0].movements[0].sets[0]
+        assert s.bodyweight is False
+        assert s.added_weight is None
+
+    def test_to_ox_preserves_bw_plus_load(self, tmp_path):
+        log = self._parse(
+            tmp_path, "@bodyweight 82kg\n2025-01-10 * pullup: BW+20kg 3x5\n"
+        )
+        assert "BW+20kg" in log.sessions[0].movements[0].to_ox()
+
+    def test_no_directive_means_log_bodyweight_none(self, tmp_path):
+        log = self._parse(tmp_path, "2025-01-10 * pullup: BW 3x8\n")
+        assert log.bodyweight is None
+
+
+class TestGroupBlocks:
+    """Test @superset/@circuit grouping within sessions."""
+
+    SUPERSET_LOG = (
+        "@session\n"
+        "2025-01-10 * Conditioning\n"
+        "squat: 100kg 3x5\n"
+        "@superset\n"
+        "pullups: BW 3x8\n"
+        "dips: BW 3x10\n"
+        "@end\n"
+        "row: 60kg 3x10\n"
+        "@end\n"
+    )
+
+    def _parse(self, tmp_path, content):
+        from ox.cli import parse_file
+
+        f = tmp_path / "groups.ox"
+        f.write_text(content)
+        return parse_file(f)
+
+    def test_superset_parses_cleanly(self, tmp_path):
+        log = self._parse(tmp_path, self.SUPERSET_LOG)
+        assert log.diagnostics == ()
+
+    def test_group_kind_and_members(self, tmp_path):
+        log = self._parse(tmp_path, self.SUPERSET_LOG)
+        session = log.sessions[0]
+        assert len(session.groups) == 1
+        group = session.groups[0]
+        assert group.kind == "superset"
+        assert [m.name for m in group.movements] == ["pullups", "dips"]
+
+    def test_circuit_kind(self, tmp_path):
+        log = self._parse(
+            tmp_path,
+            "@session\n"
+            "2025-01-10 * Metcon\n"
+            "@circuit\n"
+            "kb-swing: 24kg 5x10\n"
+            "burpee: BW 5x10\n"
+            "@end\n"
+            "@end\n",
+        )
+        assert log.sessions[0].groups[0].kind == "circuit"
+
+    def test_movements_flattened_in_order(self, tmp_path):
+        """Grouped movements appear in session.movements so analysis
+        (volume, db loading) works transparently."""
+        log = self._parse(tmp_path, self.SUPERSET_LOG)
+        names = [m.name for m in log.sessions[0].movements]
+        assert names == ["squat", "pullups", "dips", "row"]
+
+    def test_to_ox_round_trip(self, tmp_path):
+        log = self._parse(tmp_path, self.SUPERSET_LOG)
+        assert log.sessions[0].to_ox() == self.SUPERSET_LOG.rstrip("\n")
+
+    def test_ungrouped_session_has_no_groups(self, tmp_path):
+        log = self._parse(
+            tmp_path,
+            "@session\n2025-01-10 * Upper Day\nbench-press: 135lb 5x5\n@end\n",
+        )
+        assert log.sessions[0].groups == ()
+
+
+class TestUnitsDirective:
+    """Test the @units default-unit directive for unitless weights."""
+
+    def test_bare_weight_uses_default_unit(self, tmp_path):
+        from ox.cli import parse_file
+
+        f = tmp_path / "units.ox"
+        f.write_text("@units lb\n2025-01-10 * squat: 185 5x5\n")
+        log = parse_file(f)
+        assert log.default_unit == "lb"
+        s = log.sessions[0].movements[0].sets[0]
+        assert s.weight == 185 * ureg.parse_units("lb")
+
+    def test_bare_weight_without_directive_flagged(self, tmp_path):
+        from ox.cli import parse_file
+
+        f = tmp_path / "no_units.ox"
+        f.write_text("2025-01-10 * squat: 185 5x5\n")
+        log = parse_file(f)
+        assert any("@units" in d.message for d in log.diagnostics)
+        assert log.sessions[0].movements[0].sets[0].weight is None
+
+    def test_explicit_unit_wins_over_default(self, tmp_path):
+        from ox.cli import parse_file
+
+        f = tmp_path / "mixed.ox"
+        f.write_text("@units lb\n2025-01-10 * squat: 100kg 5x5\n")
+        log = parse_file(f)
+        s = log.sessions[0].movements[0].sets[0]
+        assert s.weight == 100 * ureg.parse_units("kg")
+
+    def test_default_unit_in_progressive(self):
+        assert process_weights("160/185/210", default_unit="lb") == [
+            160 * ureg.parse_units("lb"),
+            185 * ureg.parse_units("lb"),
+            210 * ureg.parse_units("lb"),
+        ]
+
+
+class TestWeightKg:
+    """Test the canonical kilogram accessor on TrainingSet."""
+
+    def test_kg_passthrough(self):
+        from ox.data import TrainingSet
+
+        s = TrainingSet(reps=5, weight=100 * ureg.parse_units("kg"))
+        assert s.weight_kg == pytest.approx(100.0)
+
+    def test_lb_converted(self):
+        from ox.data import TrainingSet
+
+        s = TrainingSet(reps=5, weight=225 * ureg.parse_units("lb"))
+        assert s.weight_kg == pytest.approx(102.058, abs=0.001)
+
+    def test_bodyweight_is_none(self):
+        from ox.data import TrainingSet
+
+        s = TrainingSet(reps=5)
+        assert s.weight_kg is None
+
+    def test_original_unit_preserved(self):
+        from ox.data import TrainingSet
+
+        s = TrainingSet(reps=5, weight=225 * ureg.parse_units("lb"))
+        assert str(s.weight.units) == "pound"
+
+
 class TestDurationToken:
     """Test that ISO 8601 PT duration strings are accepted by the grammar."""
 
@@ -299,3 +532,296 @@ class TestQueryEntryParsing:
         assert log.queries[0].date == date(2025, 1, 15)
 
     pass
+
+
+class TestFlexibleDates:
+    """Date headers accept ISO and month-name formats."""
+
+    def test_iso(self):
+        from datetime import date
+
+        assert parse_date_text("2024-03-15") == date(2024, 3, 15)
+
+    def test_month_name(self):
+        from datetime import date
+
+        assert parse_date_text("Mar 15 2024") == date(2024, 3, 15)
+
+    def test_unrecognized_raises(self):
+        with pytest.raises(ValueError, match="Unrecognized date"):
+            parse_date_text("15/03/2024")
+
+    def test_month_name_accepted_by_grammar(self):
+        _, diags = _parse_str("Mar 15 2024 * squat: 100kg 5x3\n")
+        assert not diags
+
+    def test_month_name_session_block(self):
+        content = "@session\nMar 15 2024 * Lower Day\nsquat: 100kg 5x5\n@end\n"
+        _, diags = _parse_str(content)
+        assert not diags
+
+
+class TestRest:
+    """Rest annotations after the rep scheme: rest 3m, rest 2m30s."""
+
+    def _parse(self, tmp_path, content):
+        from ox.cli import parse_file
+
+        f = tmp_path / "rest.ox"
+        f.write_text(content)
+        return parse_file(f)
+
+    @pytest.mark.parametrize(
+        "text,seconds",
+        [
+            ("rest 3m", 180),
+            ("rest 90s", 90),
+            ("rest 3min", 180),
+            ("rest 2m30s", 150),
+        ],
+    )
+    def test_parse_rest_text(self, text, seconds):
+        assert parse_rest_text(text).total_seconds() == seconds
+
+    def test_rest_populated_on_sets(self, tmp_path):
+        log = self._parse(tmp_path, "2025-01-10 * bench-press: 80kg 5x3 rest 3m\n")
+        assert log.diagnostics == ()
+        sets = log.sessions[0].movements[0].sets
+        assert all(s.rest.total_seconds() == 180 for s in sets)
+
+    def test_absent_rest_is_none(self, tmp_path):
+        log = self._parse(tmp_path, "2025-01-10 * bench-press: 80kg 5x3\n")
+        assert all(s.rest is None for s in log.sessions[0].movements[0].sets)
+
+    def test_rest_round_trips(self, tmp_path):
+        log = self._parse(tmp_path, "2025-01-10 * bench-press: 80kg 5x3 rest 2m30s\n")
+        assert (
+            log.sessions[0].movements[0].to_ox() == "bench-press: 80kg 5x3 rest 2m30s"
+        )
+
+
+class TestAmrap:
+    """Test AMRAP rep tokens and achieved-rep logging."""
+
+    def _parse(self, tmp_path, content):
+        from ox.cli import parse_file
+
+        f = tmp_path / "amrap.ox"
+        f.write_text(content)
+        return parse_file(f)
+
+    def test_open_amrap_set(self, tmp_path):
+        log = self._parse(tmp_path, "2025-01-10 ! bench-press: 80kg 1xAMRAP\n")
+        assert log.diagnostics == ()
+        sets = log.sessions[0].movements[0].sets
+        assert len(sets) == 1
+        assert sets[0].amrap is True
+        assert sets[0].reps == 0
+
+    def test_logged_amrap_records_achieved_reps(self, tmp_path):
+        log = self._parse(tmp_path, "2025-01-10 * bench-press: 80kg 5/5/AMRAP(12)\n")
+        assert log.diagnostics == ()
+        sets = log.sessions[0].movements[0].sets
+        assert [s.reps for s in sets] == [5, 5, 12]
+        assert [s.amrap for s in sets] == [False, False, True]
+
+    def test_plain_reps_are_not_amrap(self, tmp_path):
+        log = self._parse(tmp_path, "2025-01-10 * squat: 100kg 5x5\n")
+        assert all(not s.amrap for s in log.sessions[0].movements[0].sets)
+
+    def test_amrap_round_trips(self, tmp_path):
+        log = self._parse(tmp_path, "2025-01-10 * bench-press: 80kg 5/5/AMRAP(12)\n")
+        assert log.sessions[0].movements[0].to_ox() == "bench-press: 80kg 5/5/AMRAP(12)"
+
+
+class TestSetTags:
+    """Test set-level bracket tags."""
+
+    def _parse(self, tmp_path, content):
+        from ox.cli import parse_file
+
+        f = tmp_path / "tags.ox"
+        f.write_text(content)
+        return parse_file(f)
+
+    def test_single_tag(self, tmp_path):
+        log = self._parse(tmp_path, "2025-01-10 * bench-press: 80kg 3x3 [paused]\n")
+        assert log.diagnostics == ()
+        sets = log.sessions[0].movements[0].sets
+        assert all(s.tags == ("paused",) for s in sets)
+
+    def test_multiple_tags(self, tmp_path):
+        log = self._parse(tmp_path, "2025-01-10 * squat: 100kg 3x5 [tempo, belt]\n")
+        sets = log.sessions[0].movements[0].sets
+        assert all(s.tags == ("tempo", "belt") for s in sets)
+
+    def test_untagged_sets_have_empty_tags(self, tmp_path):
+        log = self._parse(tmp_path, "2025-01-10 * squat: 100kg 3x5\n")
+        assert all(s.tags == () for s in log.sessions[0].movements[0].sets)
+
+    def test_tags_round_trip(self, tmp_path):
+        log = self._parse(tmp_path, "2025-01-10 * bench-press: 80kg 3x3 [paused]\n")
+        assert log.sessions[0].movements[0].to_ox() == "bench-press: 80kg 3x3 [paused]"
+
+
+class TestTempo:
+    """Test tempo annotations (eccentric-pause-concentric-pause seconds)."""
+
+    def _parse(self, tmp_path, content):
+        from ox.cli import parse_file
+
+        f = tmp_path / "tempo.ox"
+        f.write_text(content)
+        return parse_file(f)
+
+    def test_parse_tempo_text(self):
+        assert parse_tempo_text("tempo 3-1-1-0") == (3, 1, 1, 0)
+
+    def test_tempo_populated_on_sets(self, tmp_path):
+        log = self._parse(tmp_path, "2025-01-10 * squat: 100kg 3x5 tempo 3-1-1-0\n")
+        assert log.diagnostics == ()
+        sets = log.sessions[0].movements[0].sets
+        assert all(s.tempo == (3, 1, 1, 0) for s in sets)
+
+    def test_absent_tempo_is_none(self, tmp_path):
+        log = self._parse(tmp_path, "2025-01-10 * squat: 100kg 3x5\n")
+        assert all(s.tempo is None for s in log.sessions[0].movements[0].sets)
+
+    def test_tempo_round_trips(self, tmp_path):
+        log = self._parse(tmp_path, "2025-01-10 * squat: 100kg 3x5 tempo 3-1-1-0\n")
+        assert log.sessions[0].movements[0].to_ox() == "squat: 100kg 3x5 tempo 3-1-1-0"
+
+
+class TestRepRanges:
+    """Test programmed rep-range notation: 3x(8-10)."""
+
+    def _parse(self, tmp_path, content):
+        from ox.cli import parse_file
+
+        f = tmp_path / "ranges.ox"
+        f.write_text(content)
+        return parse_file(f)
+
+    def test_range_parses_to_midpoint(self, tmp_path):
+        log = self._parse(tmp_path, "2025-01-10 ! bench-press: 80kg 3x(8-10)\n")
+        assert log.diagnostics == ()
+        sets = log.sessions[0].movements[0].sets
+        assert len(sets) == 3
+        assert all(s.reps == 9 for s in sets)
+        assert all(s.rep_min == 8 and s.rep_max == 10 for s in sets)
+
+    def test_range_reps_flagged_as_estimate(self, tmp_path):
+        log = self._parse(tmp_path, "2025-01-10 ! bench-press: 80kg 3x(8-10)\n")
+        assert all(s.reps_estimated for s in log.sessions[0].movements[0].sets)
+
+    def test_logged_actuals_not_estimated(self, tmp_path):
+        log = self._parse(tmp_path, "2025-01-10 * bench-press: 80kg 3x9\n")
+        sets = log.sessions[0].movements[0].sets
+        assert all(not s.reps_estimated for s in sets)
+        assert all(s.rep_min is None for s in sets)
+
+    def test_volume_uses_midpoint(self, tmp_path):
+        log = self._parse(tmp_path, "2025-01-10 ! bench-press: 80kg 3x(8-10)\n")
+        s = log.sessions[0].movements[0].sets[0]
+        assert s.volume.magnitude == 80 * 9
+
+    def test_range_round_trips(self, tmp_path):
+        log = self._parse(tmp_path, "2025-01-10 ! bench-press: 80kg 3x(8-10)\n")
+        assert log.sessions[0].movements[0].to_ox() == "bench-press: 80kg 3x(8-10)"
+
+
+class TestClusterSets:
+    """Test cluster set notation: 5x(3+3+3)."""
+
+    def _parse(self, tmp_path, content):
+        from ox.cli import parse_file
+
+        f = tmp_path / "clusters.ox"
+        f.write_text(content)
+        return parse_file(f)
+
+    def test_cluster_structure_preserved(self, tmp_path):
+        log = self._parse(tmp_path, "2025-01-10 * squat: 100kg 5x(3+3+3)\n")
+        assert log.diagnostics == ()
+        sets = log.sessions[0].movements[0].sets
+        assert len(sets) == 5
+        assert all(s.clusters == (3, 3, 3) for s in sets)
+
+    def test_cluster_reps_are_per_set_total(self, tmp_path):
+        log = self._parse(tmp_path, "2025-01-10 * squat: 100kg 5x(3+3+3)\n")
+        sets = log.sessions[0].movements[0].sets
+        assert all(s.reps == 9 for s in sets)
+        assert all(not s.reps_estimated for s in sets)
+
+    def test_cluster_round_trips(self, tmp_path):
+        log = self._parse(tmp_path, "2025-01-10 * squat: 100kg 5x(3+3+3)\n")
+        assert log.sessions[0].movements[0].to_ox() == "squat: 100kg 5x(3+3+3)"
+
+
+class TestWarmup:
+    """Test the warmup set marker."""
+
+    def _parse(self, tmp_path, content):
+        from ox.cli import parse_file
+
+        f = tmp_path / "warmup.ox"
+        f.write_text(content)
+        return parse_file(f)
+
+    def test_warmup_marker_sets_flag(self, tmp_path):
+        log = self._parse(tmp_path, "2025-01-10 * bench-press: 40kg 2x5 warmup\n")
+        assert log.diagnostics == ()
+        assert all(s.warmup for s in log.sessions[0].movements[0].sets)
+
+    def test_unmarked_sets_are_working_sets(self, tmp_path):
+        log = self._parse(tmp_path, "2025-01-10 * bench-press: 80kg 3x5\n")
+        assert all(not s.warmup for s in log.sessions[0].movements[0].sets)
+
+    def test_warmup_in_session_block(self, tmp_path):
+        content = (
+            "@session\n"
+            "2025-01-10 * Upper\n"
+            "bench-press: 40kg 2x5 warmup\n"
+            "bench-press: 80kg 3x5\n"
+            "@end\n"
+        )
+        log = self._parse(tmp_path, content)
+        assert log.diagnostics == ()
+        first, second = log.sessions[0].movements
+        assert all(s.warmup for s in first.sets)
+        assert all(not s.warmup for s in second.sets)
+
+    def test_warmup_round_trips(self, tmp_path):
+        log = self._parse(tmp_path, "2025-01-10 * bench-press: 40kg 2x5 warmup\n")
+        assert log.sessions[0].movements[0].to_ox() == "bench-press: 40kg 2x5 warmup"
+
+
+class TestVelocity:
+    """Test the mean bar velocity annotation (v0.45)."""
+
+    def _parse(self, tmp_path, content):
+        from ox.cli import parse_file
+
+        f = tmp_path / "velocity.ox"
+        f.write_text(content)
+        return parse_file(f)
+
+    def test_velocity_parsed_as_float(self, tmp_path):
+        log = self._parse(tmp_path, "2025-01-10 * squat: 140kg 3x3 v0.45\n")
+        assert log.diagnostics == ()
+        assert all(s.velocity == 0.45 for s in log.sessions[0].movements[0].sets)
+
+    def test_velocity_absent_is_none(self, tmp_path):
+        log = self._parse(tmp_path, "2025-01-10 * squat: 140kg 3x3\n")
+        assert all(s.velocity is None for s in log.sessions[0].movements[0].sets)
+
+    def test_velocity_round_trips(self, tmp_path):
+        log = self._parse(tmp_path, "2025-01-10 * squat: 140kg 3x3 v0.45\n")
+        assert log.sessions[0].movements[0].to_ox() == "squat: 140kg 3x3 v0.45"
+
+    def test_velocity_json_round_trips(self, tmp_path):
+        from ox.serialize import from_json, to_json
+
+        log = self._parse(tmp_path, "2025-01-10 * squat: 140kg 3x3 v0.45\n")
+        rebuilt = from_json(to_json(log))
+        assert rebuilt.sessions[0].movements[0].sets[0].velocity == 0.45
@@ -0,0 +1,94 @@
+"""Tests for the plate-loading calculator plugin."""
+
+import pytest
+
+from ox.builtins.plates import load, plates, register
+from ox.plugins import PluginContext, TextResult
+
+
+def _ctx():
+    return PluginContext(db=None, log=None)
+
+
+# --- load ---
+
+
+def test_exact_load():
+    per_side, residual = load(142.5)
+    assert per_side == [25.0, 25.0, 10.0, 1.25]
+    assert residual == 0
+
+
+def test_empty_bar():
+    per_side, residual = load(20.0)
+    assert per_side == []
+    assert residual == 0
+
+
+def test_residual_when_unreachable():
+    # Without 1.25s the closest to 142.5 is 140
+    per_side, residual = load(142.5, available=(25, 20, 15, 10, 5, 2.5))
+    assert sum(per_side) * 2 + 20 == 140.0
+    assert residual == 2.5
+
+
+def test_non_greedy_combination_found():
+    # Greedy would take 25 and get stuck at 25; 15+15 is exact
+    per_side, residual = load(80.0, available=(25, 15))
+    assert sorted(per_side, reverse=True) == [15.0, 15.0]
+    assert residual == 0
+
+
+def test_custom_bar():
+    per_side, residual = load(315, bar=45, available=(45, 25, 10, 5, 2.5))
+    assert residual == 0
+    assert sum(per_side) == 135.0
+
+
+def test_target_below_bar_raises():
+    with pytest.raises(ValueError, match="below the bar weight"):
+        load(15.0)
+
+
+# --- plugin wrapper ---
+
+
+def test_returns_text_result():
+    result = plates(_ctx(), target=142.5)
+    assert isinstance(result, TextResult)
+
+
+def test_output_shows_per_side_and_total():
+    result = plates(_ctx(), target=142.5)
+    assert "Per side: 25 + 25 + 10 + 1.25 kg" in result.text
+    assert "Total: 142.5kg" in result.text
+
+
+def test_output_shows_shortfall():
+    result = plates(_ctx(), target=142.5, available="25,20,15,10,5,2.5")
+    assert "2.5kg short" in result.text
+
+
+def test_empty_bar_output():
+    result = plates(_ctx(), target=20)
+    assert "empty bar" in result.text
+
+
+# --- registration ---
+
+
+def test_register_returns_descriptor():
+    descriptors = register()
+    assert len(descriptors) == 1
+    desc = descriptors[0]
+    assert desc["name"] == "plates"
+    assert desc["fn"] is plates
+    param_names = {p["name"] for p in desc["params"]}
+    assert param_names == {"target", "bar", "available", "unit"}
+
+
+def test_register_target_required():
+    desc = register()[0]
+    target_param = next(p for p in desc["params"] if p["name"] == "target")
+    assert target_param["required"] is True
+    assert target_param["short"] == "t"
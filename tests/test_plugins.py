@@ -2,12 +2,17 @@
 
 import textwrap
 
+import pytest
+
 from ox.data import TrainingLog
 from ox.plugins import (
     PLUGINS,
     _load_from_log_directives,
     _register_descriptors,
+    list_plugins,
     load_plugins,
+    register_plugin,
+    run_plugin,
 )
 from ox.sql_utils import plugin_usage
 
@@ -228,3 +233,63 @@ class TestPluginUsageCommand:
         usage = plugin_usage("test", entry)
         assert usage.startswith("test ")
         assert "run " not in usage
+
+
+class TestProgrammaticApi:
+    """Test register_plugin / run_plugin / list_plugins."""
+
+    def setup_method(self):
+        PLUGINS.clear()
+
+    def _descriptor(self, name="doubler"):
+        def fn(ctx, value=1):
+            return value * 2
+
+        return {
+            "name": name,
+            "fn": fn,
+            "description": "Doubles a number",
+            "params": [{"name": "value", "type": int, "default": 1}],
+        }
+
+    def test_register_and_run(self):
+        register_plugin(self._descriptor())
+        assert run_plugin("doubler", None, value=21) == 42
+
+    def test_run_uses_fn_defaults(self):
+        register_plugin(self._descriptor())
+        assert run_plugin("doubler", None) == 2
+
+    def test_register_malformed_raises(self):
+        with pytest.raises(ValueError, match="Malformed plugin descriptor"):
+            register_plugin({"description": "no name or fn"})
+
+    def test_run_unknown_plugin_raises(self):
+        with pytest.raises(KeyError, match="Unknown plugin"):
+            run_plugin("missing", None)
+
+    def test_run_unknown_argument_raises(self):
+        register_plugin(self._descriptor())
+        with pytest.raises(ValueError, match="Unknown argument"):
+            run_plugin("doubler", None, bogus=1)
+
+    def test_run_missing_required_raises(self):
+        desc = self._descriptor()
+        desc["params"][0]["required"] = True
+        register_plugin(desc)
+        with pytest.raises(ValueError, match="Missing required argument"):
+            run_plugin("doubler", None)
+
+    def test_list_plugins_sorted(self):
+        register_plugin(self._descriptor("zeta"))
+        register_plugin(self._descriptor("alpha"))
+        assert list_plugins() == [
+            ("alpha", "Doubles a number"),
+            ("zeta", "Doubles a number"),
+        ]
+
+    def test_builtin_calculators_registered(self):
+        load_plugins()
+        names = [name for name, _ in list_plugins()]
+        assert "e1rm" in names
+        assert "plates" in names
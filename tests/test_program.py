@@ -0,0 +1,105 @@
+"""Tests for template expansion with percentage loads."""
+
+from datetime import date
+
+import pytest
+
+from ox.program import expand
+from ox.units import ureg
+
+TEMPLATE = """\
+@template "week-1"
+bench-press: 85% 5x1
+squat: 100kg 5x5
+@end
+
+@template "week-2"
+bench-press: 90% 5x1
+@end
+"""
+
+
+@pytest.fixture
+def template_file(tmp_path):
+    f = tmp_path / "531.ox"
+    f.write_text(TEMPLATE)
+    return f
+
+
+class TestExpand:
+    def test_percent_substituted_against_max(self, template_file):
+        log = expand(template_file, {"bench-press": 100}, start=date(2025, 1, 6))
+        bench = log.sessions[0].movements[0]
+        assert bench.sets[0].weight == 85 * ureg.kg
+        assert len(bench.sets) == 5
+
+    def test_rounds_to_plate_increment(self, template_file):
+        log = expand(
+            template_file, {"bench-press": 102.5}, plate=2.5, start=date(2025, 1, 6)
+        )
+        bench = log.sessions[0].movements[0]
+        # 85% of 102.5 is 87.125 → rounded down to a loadable 85kg
+        assert bench.sets[0].weight == 85 * ureg.kg
+
+    def test_nearest_mode(self, template_file):
+        log = expand(
+            template_file,
+            {"bench-press": 102.5},
+            plate=2.5,
+            mode="nearest",
+            start=date(2025, 1, 6),
+        )
+        bench = log.sessions[0].movements[0]
+        assert bench.sets[0].weight == 87.5 * ureg.kg
+
+    def test_fixed_loads_pass_through(self, template_file):
+        log = expand(template_file, {"bench-press": 100}, start=date(2025, 1, 6))
+        squat = log.sessions[0].movements[1]
+        assert squat.sets[0].weight == 100 * ureg.kg
+
+    def test_one_planned_session_per_block_week_apart(self, template_file):
+        log = expand(template_file, {"bench-press": 100}, start=date(2025, 1, 6))
+        assert [s.name for s in log.sessions] == ["week-1", "week-2"]
+        assert all(s.flag == "!" for s in log.sessions)
+        assert log.sessions[1].date == date(2025, 1, 13)
+
+    def test_missing_max_raises(self, template_file):
+        with pytest.raises(ValueError, match="No training max for 'bench-press'"):
+            expand(template_file, {}, start=date(2025, 1, 6))
+
+    def test_output_is_concrete_ox(self, template_file):
+        log = expand(template_file, {"bench-press": 100}, start=date(2025, 1, 6))
+        text = log.sessions[0].to_ox()
+        assert "85kg" in text
+        assert "%" not in text
+
+    def test_lb_unit(self, template_file):
+        log = expand(
+            template_file,
+            {"bench-press": 200},
+            plate=5,
+            unit="lb",
+            start=date(2025, 1, 6),
+        )
+        bench = log.sessions[0].movements[0]
+        assert bench.sets[0].weight == 170 * ureg.lb
+
+
+class TestPercentLint:
+    def test_percent_outside_template_flagged(self, tmp_path):
+        from ox.cli import parse_file
+
+        f = tmp_path / "bad.ox"
+        f.write_text("2025-01-10 * bench-press: 85% 5x1\n")
+        log = parse_file(f)
+        assert any(
+            "only allowed inside @template" in d.message for d in log.diagnostics
+        )
+
+    def test_percent_inside_template_clean(self, tmp_path):
+        from ox.cli import parse_file
+
+        f = tmp_path / "good.ox"
+        f.write_text(TEMPLATE)
+        log = parse_file(f)
+        assert log.diagnostics == ()
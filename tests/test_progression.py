@@ -0,0 +1,164 @@
+"""Tests for the progression session writer plugin."""
+
+from datetime import date
+
+import pytest
+
+from ox.builtins.progression import (
+    DELOAD_FRACTION,
+    _parse_wave,
+    progression,
+    register,
+)
+from ox.data import Movement, TrainingLog, TrainingSession, TrainingSet
+from ox.plugins import PluginContext, TextResult
+from ox.units import ureg
+
+
+# --- _parse_wave ---
+
+
+def test_parse_wave_plain():
+    assert _parse_wave("3") == (3, 0)
+
+
+def test_parse_wave_with_deload():
+    assert _parse_wave("3+1") == (3, 1)
+
+
+# --- Session generation ---
+
+
+def _ctx(log=None):
+    return PluginContext(db=None, log=log)
+
+
+def test_returns_text_result():
+    result = progression(_ctx(), "squat", weight=100, start_date="2026-01-05")
+    assert isinstance(result, TextResult)
+
+
+def test_single_week_adds_increment():
+    result = progression(_ctx(), "squat", weight=100, start_date="2026-01-05")
+    assert "102.5kg" in result.text
+    assert "2026-01-05" in result.text
+    assert " ! " in result.text
+
+
+def test_custom_sets_and_reps():
+    result = progression(
+        _ctx(), "squat", weight=100, sets=3, reps=8, start_date="2026-01-05"
+    )
+    assert "3x8" in result.text
+
+
+def test_wave_generates_weekly_sessions():
+    result = progression(
+        _ctx(), "squat", weight=100, wave="3+1", start_date="2026-01-05"
+    )
+    for week in ("squat-week-1", "squat-week-2", "squat-week-3", "squat-week-4"):
+        assert week in result.text
+    assert "2026-01-26" in result.text
+
+
+def test_wave_weights_increase_then_deload():
+    result = progression(
+        _ctx(), "squat", weight=100, wave="3+1", start_date="2026-01-05"
+    )
+    assert "102.5kg" in result.text
+    assert "105kg" in result.text
+    assert "107.5kg" in result.text
+    # deload: 107.5 * 0.6 = 64.5, snapped down to a loadable 62.5
+    assert "62.5kg" in result.text
+    assert "deload" in result.text
+
+
+def test_nearest_mode_rounds_deload_up():
+    result = progression(
+        _ctx(), "squat", weight=100, wave="3+1", mode="nearest", start_date="2026-01-05"
+    )
+    # deload: 107.5 * 0.6 = 64.5, nearest plate is 65
+    assert "65kg" in result.text
+
+
+def test_deload_fraction():
+    assert DELOAD_FRACTION == 0.6
+
+
+def test_plate_rounding():
+    result = progression(
+        _ctx(), "bench-press", weight=61, increment=1, plate=5, start_date="2026-01-05"
+    )
+    assert "60kg" in result.text
+
+
+def test_lb_unit():
+    result = progression(
+        _ctx(),
+        "bench-press",
+        weight=200,
+        increment=5,
+        unit="lb",
+        plate=5,
+        start_date="2026-01-05",
+    )
+    assert "205lb" in result.text
+
+
+def test_weight_defaults_to_log_history():
+    session = TrainingSession(
+        date=date(2026, 1, 1),
+        flag="*",
+        name="Squat Day",
+        movements=(Movement("squat", [TrainingSet(5, 100 * ureg.kg)], None),),
+    )
+    log = TrainingLog(sessions=(session,))
+    result = progression(_ctx(log), "squat", start_date="2026-01-05")
+    assert "102.5kg" in result.text
+
+
+def test_missing_history_raises():
+    with pytest.raises(ValueError, match="No logged history"):
+        progression(_ctx(TrainingLog(sessions=())), "squat")
+
+
+def test_bodyweight_history_raises():
+    session = TrainingSession(
+        date=date(2026, 1, 1),
+        flag="*",
+        name="Pull Day",
+        movements=(Movement("pullups", [TrainingSet(10)], None),),
+    )
+    with pytest.raises(ValueError, match="no weighted sets"):
+        progression(_ctx(TrainingLog(sessions=(session,))), "pullups")
+
+
+# --- Registration ---
+
+
+def test_register_returns_descriptor():
+    descriptors = register()
+    assert len(descriptors) == 1
+    desc = descriptors[0]
+    assert desc["name"] == "progression"
+    assert desc["fn"] is progression
+    param_names = {p["name"] for p in desc["params"]}
+    assert param_names == {
+        "movement",
+        "weight",
+        "increment",
+        "sets",
+        "reps",
+        "wave",
+        "unit",
+        "plate",
+        "mode",
+        "start_date",
+    }
+
+
+def test_register_movement_required():
+    desc = register()[0]
+    movement_param = next(p for p in desc["params"] if p["name"] == "movement")
+    assert movement_param["required"] is True
+    assert movement_param["short"] == "m"
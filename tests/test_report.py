@@ -0,0 +1,130 @@
+"""Tests for the Markdown report export."""
+
+import io
+from datetime import date
+
+from ox.data import Movement, TrainingLog, TrainingSession, TrainingSet
+from ox.report import MarkdownOptions, to_markdown, write_markdown
+from ox.units import ureg
+
+
+def _session(day, weight_kg=100, reps=5, warmup=False):
+    return TrainingSession(
+        date=day,
+        flag="*",
+        name="Lower Day",
+        movements=(
+            Movement(
+                "squat",
+                [TrainingSet(reps, weight_kg * ureg.kg, warmup=warmup)] * 3,
+                None,
+            ),
+        ),
+    )
+
+
+def _log(sessions):
+    return TrainingLog(sessions=tuple(sessions))
+
+
+class TestToMarkdown:
+    def test_header_has_human_dates_and_totals(self):
+        md = to_markdown(
+            _log([_session(date(2025, 1, 6)), _session(date(2025, 1, 13))])
+        )
+        assert md.splitlines()[0] == "# Training report — Jan 6, 2025 – Jan 13, 2025"
+        assert "- Sessions: 2" in md
+        assert "- Working sets: 6" in md
+        assert "- Tonnage: 3,000 kg" in md
+
+    def test_weekly_tonnage_rows(self):
+        md = to_markdown(
+            _log([_session(date(2025, 1, 6)), _session(date(2025, 1, 13))])
+        )
+        assert "## Weekly tonnage" in md
+        assert "| Jan 6, 2025 | 1 | 1,500 |" in md
+        assert "| Jan 13, 2025 | 1 | 1,500 |" in md
+
+    def test_pr_highlights_only_in_range(self):
+        log = _log(
+            [
+                _session(date(2025, 1, 6), weight_kg=140),
+                _session(date(2025, 2, 3), weight_kg=120),
+            ]
+        )
+        md = to_markdown(log, start=date(2025, 2, 1), end=date(2025, 2, 28))
+        # The all-time squat PR predates the range, so nothing to highlight
+        assert "No new records in this period." in md
+
+    def test_pr_highlight_lists_new_record(self):
+        log = _log(
+            [
+                _session(date(2025, 1, 6), weight_kg=120),
+                _session(date(2025, 1, 20), weight_kg=140, reps=3),
+            ]
+        )
+        md = to_markdown(log)
+        assert "- **squat** — 140kg × 3" in md
+        assert "on Jan 20, 2025" in md
+
+    def test_best_lifts_table_sorted(self):
+        bench = TrainingSession(
+            date=date(2025, 1, 7),
+            flag="*",
+            name="Upper Day",
+            movements=(Movement("bench-press", [TrainingSet(5, 100 * ureg.kg)], None),),
+        )
+        md = to_markdown(_log([_session(date(2025, 1, 6)), bench]))
+        lifts = md.split("## Best lifts")[1]
+        assert lifts.index("bench-press") < lifts.index("squat")
+
+    def test_warmups_excluded(self):
+        log = _log(
+            [
+                _session(date(2025, 1, 6)),
+                _session(date(2025, 1, 6), weight_kg=60, warmup=True),
+            ]
+        )
+        md = to_markdown(log)
+        assert "- Working sets: 3" in md
+        assert "- Tonnage: 1,500 kg" in md
+
+    def test_sections_toggle_off(self):
+        log = _log([_session(date(2025, 1, 6))])
+        md = to_markdown(
+            log,
+            options=MarkdownOptions(weekly_tonnage=False, prs=False, best_lifts=False),
+        )
+        assert "## Weekly tonnage" not in md
+        assert "## PR highlights" not in md
+        assert "## Best lifts" not in md
+        assert "- Sessions: 1" in md
+
+    def test_deterministic(self):
+        log = _log([_session(date(2025, 1, 6)), _session(date(2025, 1, 13))])
+        assert to_markdown(log) == to_markdown(log)
+
+    def test_empty_range(self):
+        md = to_markdown(_log([]), start=date(2025, 1, 1), end=date(2025, 1, 31))
+        assert "No completed sessions in this period." in md
+
+
+class TestWriteMarkdown:
+    def test_matches_to_markdown(self):
+        log = _log([_session(date(2025, 1, 6))])
+        buf = io.StringIO()
+        write_markdown(log, buf)
+        assert buf.getvalue() == to_markdown(log)
+
+
+class TestOpenEndedAmrap:
+    def test_open_amrap_does_not_crash(self, tmp_path):
+        from ox.cli import parse_file
+
+        f = tmp_path / "amrap.ox"
+        f.write_text(
+            "2025-01-06 * bench-press: 80kg 3x5\n"
+            "2025-01-13 * bench-press: 80kg 1xAMRAP\n"
+        )
+        md = to_markdown(parse_file(f))
+        assert "bench-press" in md
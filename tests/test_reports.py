@@ -2,7 +2,9 @@
 
 import pytest
 
+from ox.cli import parse_file
 from ox.data import TrainingLog
+from ox.db import create_db
 from ox.plugins import PLUGINS, PluginContext, TableResult, load_plugins
 from ox.sql_utils import _time_bin_expr, parse_plugin_args, plugin_usage
 from ox.builtins.volume import volume
@@ -103,6 +105,25 @@ class TestVolumeOverTime:
         # Some pullup sets are bodyweight (NULL magnitude), so volume may be None
         assert len(rows) > 0
 
+    def test_bodyweight_sets_noted(self, tmp_path):
+        # A log without @bodyweight: BW sets have no resolved weight
+        from ox.cli import parse_file
+        from ox.db import create_db
+
+        f = tmp_path / "bw.ox"
+        f.write_text("2025-01-10 * pullup: BW 5x10\n")
+        db = create_db(parse_file(f))
+        ctx = PluginContext(db=db, log=TrainingLog(sessions=()))
+        result = volume(ctx, movement="pullup")
+        assert result.note is not None
+        assert "bodyweight" in result.note
+        assert "@bodyweight" in result.note
+
+    def test_no_note_for_weighted_movement(self, simple_db):
+        log = TrainingLog(sessions=())
+        ctx = PluginContext(db=simple_db, log=log)
+        assert volume(ctx, movement="bench-press").note is None
+
     def test_nonexistent_movement(self, example_db):
         _, rows = self._run(example_db, movement="nonexistent-exercise")
         assert rows == []
@@ -121,6 +142,81 @@ class TestVolumeOverTime:
         assert rows[0][3] == 135.0  # avg_weight_per_rep
 
 
+class TestVolumeSummary:
+    """Test log-wide volume summaries (no movement filter)."""
+
+    def _run(self, db, **kwargs):
+        ctx = PluginContext(db=db, log=TrainingLog(sessions=()))
+        result = volume(ctx, **kwargs)
+        assert isinstance(result, TableResult)
+        return result.columns, result.rows
+
+    def test_total_is_default_group(self, example_db):
+        columns, rows = self._run(example_db)
+        assert columns == ["period", "total_volume (lb)", "total_reps", "total_sets"]
+        assert len(rows) > 1
+
+    def test_total_set_counts(self, simple_db):
+        _, rows = self._run(simple_db, bin="daily")
+        by_period = {row[0]: row for row in rows}
+        # simple_log: 5x5 bench + 3 kb-oh-press sets on 2025-01-11
+        assert by_period["2025-01-11"][3] == 8
+
+    @pytest.fixture
+    def gap_db(self, tmp_path):
+        """Log with a three-day gap between training days."""
+        f = tmp_path / "gap.ox"
+        f.write_text("2025-01-10 * squat: 100kg 5x5\n2025-01-14 * squat: 102.5kg 5x5\n")
+        conn = create_db(parse_file(f))
+        yield conn
+        conn.close()
+
+    def test_gap_days_zero_filled(self, gap_db):
+        _, rows = self._run(gap_db, bin="daily")
+        periods = [row[0] for row in rows]
+        assert periods == [
+            "2025-01-10",
+            "2025-01-11",
+            "2025-01-12",
+            "2025-01-13",
+            "2025-01-14",
+        ]
+        gap = next(row for row in rows if row[0] == "2025-01-12")
+        assert gap[1:] == (0.0, 0, 0)
+
+    def test_fill_false_skips_gaps(self, gap_db):
+        _, rows = self._run(gap_db, bin="daily", fill="false")
+        assert [row[0] for row in rows] == ["2025-01-10", "2025-01-14"]
+
+    def test_fill_true_for_single_movement(self, gap_db):
+        _, rows = self._run(gap_db, movement="squat", bin="daily", fill="true")
+        assert len(rows) == 5
+        assert any(row[1:] == (0.0, 0, None) for row in rows)
+
+    def test_group_by_movement(self, example_db):
+        columns, rows = self._run(example_db, group="movement")
+        assert columns[1] == "movement"
+        movements = {row[1] for row in rows}
+        assert "squat" in movements
+
+    def test_group_by_tag(self, example_db):
+        columns, rows = self._run(example_db, group="tag")
+        assert columns[1] == "tag"
+        tags = {row[1] for row in rows}
+        assert "squat" in tags or "lower" in tags
+
+    def test_monthly_gap_fill(self, example_db):
+        _, rows = self._run(example_db, bin="monthly")
+        periods = [row[0] for row in rows]
+        assert periods == sorted(periods)
+        for row in rows:
+            assert len(row[0]) == 7  # "2024-01"
+
+    def test_unknown_group_raises(self, example_db):
+        with pytest.raises(ValueError, match="Unknown group"):
+            self._run(example_db, group="session")
+
+
 class TestParsePluginArgs:
     """Test the argument parser."""
 
@@ -264,3 +360,35 @@ class TestRegistry:
         for name, entry in PLUGINS.items():
             assert "params" in entry, f"Plugin '{name}' missing 'params'"
             assert isinstance(entry["params"], list)
+
+
+class TestVolumeWarmups:
+    """Test warmup exclusion in the volume plugin."""
+
+    def _db(self, tmp_path):
+        f = tmp_path / "log.ox"
+        f.write_text(
+            "@session\n"
+            "2025-01-10 * Upper\n"
+            "bench-press: 40kg 2x5 warmup\n"
+            "bench-press: 80kg 3x5\n"
+            "@end\n"
+        )
+        return create_db(parse_file(f))
+
+    def test_warmups_excluded_by_default(self, tmp_path):
+        ctx = PluginContext(db=self._db(tmp_path), log=TrainingLog(sessions=()))
+        result = volume(ctx, movement="bench-press", unit="kg")
+        assert result.rows[0][1] == 80 * 15  # working sets only
+        assert result.rows[0][2] == 15
+
+    def test_warmups_included_on_request(self, tmp_path):
+        ctx = PluginContext(db=self._db(tmp_path), log=TrainingLog(sessions=()))
+        result = volume(ctx, movement="bench-press", unit="kg", warmups="true")
+        assert result.rows[0][1] == 80 * 15 + 40 * 10
+        assert result.rows[0][2] == 25
+
+    def test_summary_set_count_excludes_warmups(self, tmp_path):
+        ctx = PluginContext(db=self._db(tmp_path), log=TrainingLog(sessions=()))
+        result = volume(ctx, unit="kg")
+        assert result.rows[0][3] == 3  # total_sets
@@ -0,0 +1,62 @@
+"""Tests for plate-rounding strategies."""
+
+import pytest
+
+from ox.rounding import Rounding
+
+
+class TestRounding:
+    @pytest.mark.parametrize(
+        "weight, increment, expected",
+        [
+            (101.2, 2.5, 100.0),
+            (101.3, 2.5, 102.5),
+            (103.74, 2.5, 102.5),
+            (207.4, 5, 205),
+            (100.0, 2.5, 100.0),
+        ],
+    )
+    def test_nearest(self, weight, increment, expected):
+        assert Rounding(increment, "nearest").round(weight) == expected
+
+    @pytest.mark.parametrize(
+        "weight, increment, expected",
+        [
+            (101.2, 2.5, 100.0),
+            (102.4, 2.5, 100.0),
+            (87.125, 2.5, 85.0),
+            (100.0, 2.5, 100.0),
+        ],
+    )
+    def test_down(self, weight, increment, expected):
+        assert Rounding(increment, "down").round(weight) == expected
+
+    @pytest.mark.parametrize(
+        "weight, increment, expected",
+        [
+            (100.1, 2.5, 102.5),
+            (87.125, 2.5, 87.5),
+            (100.0, 2.5, 100.0),
+        ],
+    )
+    def test_up(self, weight, increment, expected):
+        assert Rounding(increment, "up").round(weight) == expected
+
+    def test_microplate_increment(self):
+        assert Rounding(1.25, "down").round(87.125) == 86.25
+
+    def test_default_is_down(self):
+        assert Rounding().mode == "down"
+        assert Rounding().round(101.2) == 100.0
+
+    def test_float_noise_does_not_underround(self):
+        # 86.25 is exactly 69 * 1.25 but float division gives 68.999...
+        assert Rounding(1.25, "down").round(86.25) == 86.25
+
+    def test_unknown_mode_raises(self):
+        with pytest.raises(ValueError, match="Unknown rounding mode"):
+            Rounding(2.5, "sideways")
+
+    def test_nonpositive_increment_raises(self):
+        with pytest.raises(ValueError, match="must be positive"):
+            Rounding(0, "down")
@@ -0,0 +1,50 @@
+"""Tests for bodyweight-adjusted strength scores."""
+
+import pytest
+
+from ox.score import Sex, dots, wilks
+
+
+class TestDots:
+    def test_known_male_coefficient(self):
+        # Published DOTS coefficient for a 100kg male is ~0.6155
+        assert dots(700, 100, Sex.MALE) == pytest.approx(430.9, abs=0.5)
+
+    def test_known_female_coefficient(self):
+        # Published DOTS coefficient for a 60kg female is ~1.1085
+        assert dots(300, 60, Sex.FEMALE) == pytest.approx(332.6, abs=1.0)
+
+    def test_heavier_lifter_scores_lower_for_same_total(self):
+        assert dots(600, 120, Sex.MALE) < dots(600, 80, Sex.MALE)
+
+    def test_scales_linearly_with_total(self):
+        assert dots(600, 100, Sex.MALE) == pytest.approx(2 * dots(300, 100, Sex.MALE))
+
+    def test_below_range_raises(self):
+        with pytest.raises(ValueError, match="outside supported range"):
+            dots(100, 30, Sex.MALE)
+
+    def test_above_range_raises(self):
+        with pytest.raises(ValueError, match="outside supported range"):
+            dots(100, 160, Sex.FEMALE)
+
+
+class TestWilks:
+    def test_known_male_coefficient(self):
+        # Published Wilks coefficient for an 80kg male is ~0.6827
+        assert wilks(500, 80, Sex.MALE) == pytest.approx(341.4, abs=0.5)
+
+    def test_known_female_coefficient(self):
+        # Published Wilks coefficient for a 60kg female is ~1.1149
+        assert wilks(300, 60, Sex.FEMALE) == pytest.approx(334.5, abs=1.5)
+
+    def test_heavier_lifter_scores_lower_for_same_total(self):
+        assert wilks(600, 120, Sex.MALE) < wilks(600, 80, Sex.MALE)
+
+    def test_below_range_raises(self):
+        with pytest.raises(ValueError, match="outside supported range"):
+            wilks(100, 20, Sex.FEMALE)
+
+    def test_range_message_names_sex(self):
+        with pytest.raises(ValueError, match="for male"):
+            wilks(100, 250, Sex.MALE)
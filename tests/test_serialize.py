@@ -0,0 +1,132 @@
+"""Tests for JSON serialization (to_json/from_json round-trips)."""
+
+import json
+from datetime import date, time
+
+from ox.data import (
+    Movement,
+    MovementDefinition,
+    MovementGroup,
+    Note,
+    StoredQuery,
+    TrainingLog,
+    TrainingSession,
+    TrainingSet,
+    WeighIn,
+)
+from ox.serialize import SCHEMA_VERSION, from_json, to_json
+from ox.units import ureg
+
+
+def _simple_log():
+    bench = Movement(
+        "bench-press",
+        [TrainingSet(5, 100 * ureg.kg, rpe=8.0), TrainingSet(5, 100 * ureg.kg)],
+        "paused",
+    )
+    return TrainingLog(
+        sessions=(
+            TrainingSession(
+                date=date(2025, 1, 10),
+                flag="*",
+                name="Upper Day",
+                movements=(bench,),
+                notes=(Note("felt strong"),),
+            ),
+        ),
+        notes=(Note("deload week", date=date(2025, 1, 12)),),
+        queries=(StoredQuery("recent", "SELECT 1", date(2025, 1, 10)),),
+        weigh_ins=(WeighIn(date(2025, 1, 10), 185 * ureg.lb, time(6, 30), "home"),),
+        movement_definitions=(
+            MovementDefinition("bench-press", equipment="barbell", tags=("press",)),
+        ),
+        default_unit="lb",
+        bodyweight=82 * ureg.kg,
+    )
+
+
+class TestToJson:
+    def test_version_and_date_format(self):
+        data = json.loads(to_json(_simple_log()))
+        assert data["version"] == SCHEMA_VERSION
+        assert data["sessions"][0]["date"] == "2025-01-10"
+
+    def test_weight_carries_unit(self):
+        data = json.loads(to_json(_simple_log()))
+        weight = data["sessions"][0]["movements"][0]["sets"][0]["weight"]
+        assert weight == {"magnitude": 100.0, "unit": "kilogram"}
+
+    def test_optional_set_fields_omitted(self):
+        data = json.loads(to_json(_simple_log()))
+        sets = data["sessions"][0]["movements"][0]["sets"]
+        assert sets[0]["rpe"] == 8.0
+        assert "rpe" not in sets[1]
+        assert "rir" not in sets[1]
+        assert "bodyweight" not in sets[1]
+
+    def test_empty_log_omits_sections(self):
+        data = json.loads(to_json(TrainingLog(sessions=())))
+        assert data == {"version": SCHEMA_VERSION, "sessions": []}
+
+    def test_groups_serialize_as_indices(self):
+        curls = Movement("curls", [TrainingSet(10, 20 * ureg.kg)], None)
+        rows = Movement("rows", [TrainingSet(10, 60 * ureg.kg)], None)
+        session = TrainingSession(
+            date=date(2025, 1, 10),
+            flag="*",
+            name="Pull",
+            movements=(curls, rows),
+            groups=(MovementGroup("superset", (curls, rows)),),
+        )
+        data = json.loads(to_json(TrainingLog(sessions=(session,))))
+        assert data["sessions"][0]["groups"] == [
+            {"kind": "superset", "movements": [0, 1]}
+        ]
+
+
+class TestFromJson:
+    def test_round_trip_is_equal(self):
+        log = _simple_log()
+        assert from_json(to_json(log)) == log
+
+    def test_round_trip_preserves_units(self):
+        log = from_json(to_json(_simple_log()))
+        assert log.weigh_ins[0].weight == 185 * ureg.lb
+        assert str(log.weigh_ins[0].weight.units) == "pound"
+
+    def test_bodyweight_set_round_trips(self):
+        pullups = Movement(
+            "pullups",
+            [TrainingSet(10, bodyweight=True, added_weight=10 * ureg.kg)],
+            None,
+        )
+        log = TrainingLog(
+            sessions=(
+                TrainingSession(
+                    date=date(2025, 1, 10),
+                    flag="*",
+                    name=None,
+                    movements=(pullups,),
+                ),
+            )
+        )
+        restored = from_json(to_json(log))
+        restored_set = restored.sessions[0].movements[0].sets[0]
+        assert restored_set.bodyweight is True
+        assert restored_set.added_weight == 10 * ureg.kg
+        assert restored_set.weight is None
+
+    def test_groups_reference_session_movements(self):
+        curls = Movement("curls", [TrainingSet(10, 20 * ureg.kg)], None)
+        rows = Movement("rows", [TrainingSet(10, 60 * ureg.kg)], None)
+        session = TrainingSession(
+            date=date(2025, 1, 10),
+            flag="*",
+            name="Pull",
+            movements=(curls, rows),
+            groups=(MovementGroup("superset", (curls, rows)),),
+        )
+        restored = from_json(to_json(TrainingLog(sessions=(session,))))
+        group = restored.sessions[0].groups[0]
+        assert group.movements[0] is restored.sessions[0].movements[0]
+        assert restored.sessions[0].to_ox() == session.to_ox()
@@ -0,0 +1,61 @@
+"""Tests for strength-standard classification."""
+
+import pytest
+
+from ox.standards import LEVELS, level, supported_movements
+
+
+class TestLevel:
+    def test_untrained_below_first_threshold(self):
+        classification, ratio = level("squat", 50, 80, "male")
+        assert classification == "untrained"
+        assert ratio == 0.62
+
+    def test_intermediate_male_squat(self):
+        classification, _ = level("squat", 120, 80, "male")
+        assert classification == "intermediate"
+
+    def test_elite_at_threshold(self):
+        classification, ratio = level("squat", 180, 80, "male")
+        assert classification == "elite"
+        assert ratio == 2.25
+
+    def test_female_thresholds_differ(self):
+        male, _ = level("bench-press", 60, 70, "male")
+        female, _ = level("bench-press", 60, 70, "female")
+        assert male == "novice"
+        assert female == "intermediate"
+
+    def test_masters_age_credit(self):
+        young, young_ratio = level("deadlift", 115, 80, "male", age=30)
+        masters, masters_ratio = level("deadlift", 115, 80, "male", age=60)
+        assert young == "novice"
+        assert masters == "intermediate"
+        assert masters_ratio > young_ratio
+
+    def test_age_under_forty_no_credit(self):
+        _, at_25 = level("squat", 100, 80, "male", age=25)
+        _, at_40 = level("squat", 100, 80, "male", age=40)
+        assert at_25 == at_40
+
+    def test_unknown_movement_raises(self):
+        with pytest.raises(ValueError, match="No strength standard table"):
+            level("kb-swing", 48, 80, "male")
+
+    def test_unknown_sex_raises(self):
+        with pytest.raises(ValueError, match="Unknown sex"):
+            level("squat", 100, 80, "m")
+
+    def test_nonpositive_bodyweight_raises(self):
+        with pytest.raises(ValueError, match="Bodyweight must be positive"):
+            level("squat", 100, 0, "male")
+
+
+class TestTables:
+    def test_big_lifts_supported(self):
+        movements = supported_movements()
+        for name in ("squat", "bench-press", "deadlift", "overhead-press"):
+            assert name in movements
+
+    def test_levels_order(self):
+        assert LEVELS == ("untrained", "novice", "intermediate", "advanced", "elite")
@@ -0,0 +1,70 @@
+"""Tests for epsilon-tolerant weight comparison."""
+
+from datetime import date
+
+from ox.data import Movement, TrainingLog, TrainingSession, TrainingSet
+from ox.units import KG_EPSILON, compare_weights, ureg, weights_equal
+
+
+def _round_tripped_kg(kg):
+    """A kg value dragged through lb and back, picking up float noise."""
+    return (kg * ureg.kg).to("pound").to("kilogram")
+
+
+class TestWeightsEqual:
+    def test_identical(self):
+        assert weights_equal(100.0, 100.0)
+
+    def test_conversion_noise_is_equal(self):
+        noisy = float(_round_tripped_kg(100).magnitude)
+        assert weights_equal(100.0, noisy)
+
+    def test_beyond_epsilon_differs(self):
+        assert not weights_equal(100.0, 100.0 + 2 * KG_EPSILON)
+
+    def test_custom_epsilon(self):
+        assert weights_equal(100.0, 100.4, epsilon=0.5)
+        assert not weights_equal(100.0, 100.4, epsilon=0.1)
+
+
+class TestCompareWeights:
+    def test_equal_within_epsilon_is_zero(self):
+        assert compare_weights(100.0, 100.005) == 0
+
+    def test_ordering(self):
+        assert compare_weights(100.0, 102.5) == -1
+        assert compare_weights(102.5, 100.0) == 1
+
+
+class TestConversionNoisePrs:
+    def _session(self, day, weight):
+        return TrainingSession(
+            date=day,
+            flag="*",
+            name="Session",
+            movements=(Movement("squat", [TrainingSet(5, weight)], None),),
+        )
+
+    def test_round_tripped_weight_is_not_a_new_record(self):
+        # Same lift logged in kg, then in its noisy lb equivalent: the
+        # record keeps the original (earlier) date
+        noisy = _round_tripped_kg(100)
+        log = TrainingLog(
+            sessions=(
+                self._session(date(2025, 1, 6), 100 * ureg.kg),
+                self._session(date(2025, 1, 13), noisy),
+            )
+        )
+        record = log.personal_records()["squat"]
+        assert record.max_weight_date == date(2025, 1, 6)
+        assert record.best_e1rm_date == date(2025, 1, 6)
+
+    def test_real_improvement_still_sets_record(self):
+        log = TrainingLog(
+            sessions=(
+                self._session(date(2025, 1, 6), 100 * ureg.kg),
+                self._session(date(2025, 1, 13), 102.5 * ureg.kg),
+            )
+        )
+        record = log.personal_records()["squat"]
+        assert record.max_weight_date == date(2025, 1, 13)
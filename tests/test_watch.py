@@ -0,0 +1,101 @@
+"""Tests for the file-watch reload loop."""
+
+import time
+from threading import Event, Thread
+
+from ox.watch import watch
+
+VALID = "2025-01-10 * squat: 100kg 5x5\n"
+UPDATED = "2025-01-10 * squat: 100kg 5x5\n2025-01-11 * bench-press: 80kg 5x5\n"
+BROKEN = "2025-01-10 squat 100kg\n"
+
+
+class _Collector:
+    """Records every log passed to on_update."""
+
+    def __init__(self):
+        self.logs = []
+        self.called = Event()
+
+    def __call__(self, log):
+        self.logs.append(log)
+        self.called.set()
+
+    def wait_for(self, count, timeout=5.0):
+        deadline = time.monotonic() + timeout
+        while len(self.logs) < count and time.monotonic() < deadline:
+            time.sleep(0.01)
+        return len(self.logs) >= count
+
+
+def _start_watch(path, collector, stop):
+    thread = Thread(
+        target=watch,
+        args=(path, collector),
+        kwargs={"interval": 0.02, "settle": 0.02, "stop": stop},
+        daemon=True,
+    )
+    thread.start()
+    return thread
+
+
+def test_initial_parse_delivered(tmp_path):
+    f = tmp_path / "log.ox"
+    f.write_text(VALID)
+    collector = _Collector()
+    stop = Event()
+    thread = _start_watch(f, collector, stop)
+    try:
+        assert collector.wait_for(1)
+        assert len(collector.logs[0].sessions) == 1
+    finally:
+        stop.set()
+        thread.join(timeout=5)
+
+
+def test_change_triggers_reload(tmp_path):
+    f = tmp_path / "log.ox"
+    f.write_text(VALID)
+    collector = _Collector()
+    stop = Event()
+    thread = _start_watch(f, collector, stop)
+    try:
+        assert collector.wait_for(1)
+        f.write_text(UPDATED)
+        assert collector.wait_for(2)
+        assert len(collector.logs[-1].sessions) == 2
+    finally:
+        stop.set()
+        thread.join(timeout=5)
+
+
+def test_errors_reported_and_watch_continues(tmp_path):
+    f = tmp_path / "log.ox"
+    f.write_text(VALID)
+    collector = _Collector()
+    stop = Event()
+    thread = _start_watch(f, collector, stop)
+    try:
+        assert collector.wait_for(1)
+        f.write_text(BROKEN)
+        assert collector.wait_for(2)
+        assert collector.logs[-1].diagnostics != ()
+        # A later fix is still picked up
+        f.write_text(UPDATED)
+        assert collector.wait_for(3)
+        assert collector.logs[-1].diagnostics == ()
+    finally:
+        stop.set()
+        thread.join(timeout=5)
+
+
+def test_stop_ends_loop(tmp_path):
+    f = tmp_path / "log.ox"
+    f.write_text(VALID)
+    collector = _Collector()
+    stop = Event()
+    thread = _start_watch(f, collector, stop)
+    assert collector.wait_for(1)
+    stop.set()
+    thread.join(timeout=5)
+    assert not thread.is_alive()
@@ -13,7 +13,7 @@ module.exports = grammar({
   extras: ($) => [/[ \t]/], // Only spaces and tabs, NOT newlines
 
   rules: {
-    source_file: ($) => repeat(choice($._entry, $.include_directive, $.plugin_directive, $.comment, "\n")),
+    source_file: ($) => repeat(choice($._entry, $.include_directive, $.plugin_directive, $.units_directive, $.bodyweight_directive, $.goal_directive, $.comment, "\n")),
 
     _entry: ($) => choice(
       $.singleline_entry,
@@ -23,6 +23,7 @@ module.exports = grammar({
       $.note_entry,
       $.query_entry,
       $.weigh_in_entry,
+      $.rest_entry,
     ),
 
     include_directive: ($) => prec.right(seq(
@@ -37,6 +38,28 @@ module.exports = grammar({
       optional("\n")
     )),
 
+    // Default unit for unitless weights in this file: @units lb
+    units_directive: ($) => prec.right(seq(
+      "@units",
+      field("unit", $.identifier),
+      optional("\n")
+    )),
+
+    // The lifter's bodyweight, for bodyweight-movement tonnage: @bodyweight 82kg
+    bodyweight_directive: ($) => prec.right(seq(
+      "@bodyweight",
+      field("weight", $.weight),
+      optional("\n")
+    )),
+
+    // A strength goal for a movement's 1RM: @goal squat 180kg
+    goal_directive: ($) => prec.right(seq(
+      "@goal",
+      field("movement", $.identifier),
+      field("weight", $.weight),
+      optional("\n")
+    )),
+
     file_path: ($) => seq('"', /[^"\n]+/, '"'),
 
     comment: ($) => /#[^\n]*/,
@@ -49,6 +72,7 @@ module.exports = grammar({
         field("item", $.item),
         ":",
         optional(field("details", $.details)),
+        optional(field("comment", $.comment)),
         optional("\n")
       )),
 
@@ -72,6 +96,16 @@ module.exports = grammar({
         optional("\n")
       )),
 
+    // Intentional rest day: date rest optional("note"). Marked rest days
+    // don't break training streaks (see analysis.consistency).
+    rest_entry: ($) =>
+      prec.right(seq(
+        field("date", $.date),
+        "rest",
+        optional(field("note", $.quoted_string)),
+        optional("\n")
+      )),
+
     // Query entry: date query "name" "SQL"
     query_entry: ($) =>
       prec.right(seq(
@@ -91,11 +125,21 @@ module.exports = grammar({
         field("flag", $.flag),
         field("name", $.name),
         "\n",
-        repeat(choice($.item_line, $.note_line)),
+        repeat(choice($.item_line, $.note_line, $.group_block, seq($.comment, "\n"), "\n")),
         "@end",
         optional("\n")
       )),
 
+    // @superset / @circuit group within a session: movements performed together
+    group_block: ($) =>
+      seq(
+        field("kind", choice("@superset", "@circuit")),
+        "\n",
+        repeat1($.item_line),
+        "@end",
+        "\n"
+      ),
+
     // @movement block
     movement_block: ($) =>
       prec.right(seq(
@@ -124,6 +168,7 @@ module.exports = grammar({
         field("item", $.item),
         ":",
         field("details", $.details),
+        optional(field("comment", $.comment)),
         "\n"
       ),
 
@@ -144,7 +189,12 @@ module.exports = grammar({
         "\n"
       ),
 
-    date: ($) => /\d{4}-\d{2}-\d{2}/,
+    // ISO YYYY-MM-DD (preferred) or month-name form like "Mar 15 2024"
+    // (accepted with a lint warning; see collect_semantic_diagnostics)
+    date: ($) => token(choice(
+      /\d{4}-\d{2}-\d{2}/,
+      /[A-Z][a-z]{2} \d{1,2} \d{4}/
+    )),
 
     flag: ($) => choice("*", "!"),
 
@@ -165,9 +215,16 @@ module.exports = grammar({
       repeat1(
         choice(
           field("weight", $.weight),
+          field("percent", $.percent),
           field("rep_scheme", $.rep_scheme),
           field("duration", $.duration),
           field("distance", $.distance),
+          field("effort", $.effort),
+          field("rest", $.rest),
+          field("tempo", $.tempo),
+          field("velocity", $.velocity),
+          field("warmup", $.warmup),
+          field("tags", $.set_tags),
           field("note", $.quoted_string)
         )
       ),
@@ -179,10 +236,41 @@ module.exports = grammar({
       /\d+(\.\d+)?(g|gram|kg|kilogram|lb|pound|oz|ounce|stone|t|tonne|grain|gr|ct|carat)((\+\d+(\.\d+)?(g|gram|kg|kilogram|lb|pound|oz|ounce|stone|t|tonne|grain|gr|ct|carat))+)?/,  // single or combined: 24kg or 24kg+32kg
       /((BW|\d+(\.\d+)?(g|gram|kg|kilogram|lb|pound|oz|ounce|stone|t|tonne|grain|gr|ct|carat)?)\/)+(BW|\d+(\.\d+)?(g|gram|kg|kilogram|lb|pound|oz|ounce|stone|t|tonne|grain|gr|ct|carat))/,  // progressive (incl. implied units + mixed BW): 24kg/32kg, 160/185/210lb, BW/25lb, 60/70kg/160/180lb
       /\d+(\.\d+)?(g|gram|kg|kilogram|lb|pound|oz|ounce|stone|t|tonne|grain|gr|ct|carat)/,  // single: 24kg
-      /BW/                                // bodyweight standalone
+      /[Bb][Ww]\+\d+(\.\d+)?(g|gram|kg|kilogram|lb|pound|oz|ounce|stone|t|tonne|grain|gr|ct|carat)/,  // bodyweight plus added load: BW+20kg
+      /[Bb][Ww]/,                         // bodyweight standalone
+      /\d+(\.\d+)?/                       // unitless: 100 (needs an @units default; see lint)
     )),
 
-    rep_scheme: ($) => /(\d+x\d+)|(\d+(\/\d+)+)/,  // 4x4 or 5/5/5
+    // Percentage of a training max: 85%. Only meaningful inside @template
+    // blocks; elsewhere it's a semantic lint error.
+    percent: ($) => /\d+(\.\d+)?%/,
+
+    // 4x4, 5/5/5; AMRAP as a rep token (achieved reps in parens when
+    // logged): 1xAMRAP, 5/5/AMRAP(12); rep ranges and cluster sets in
+    // parens after x: 3x(8-10), 5x(3+3+3)
+    rep_scheme: ($) => /(\d+x(\d+|AMRAP(\(\d+\))?|\((\d+-\d+|\d+(\+\d+)+)\)))|((\d+|AMRAP(\(\d+\))?)(\/(\d+|AMRAP(\(\d+\))?))+)/,
+
+    // Effort annotation after reps: @8 or @7.5 (RPE), @RIR2 (reps in reserve)
+    effort: ($) => token(choice(/@\d+(\.\d+)?/, /@[Rr][Ii][Rr]\d+/)),
+
+    // Rest between sets: rest 3m, rest 90s, rest 3min, rest 2m30s
+    rest: ($) => token(choice(
+      /rest[ \t]+\d+m\d+(\.\d+)?s/,
+      /rest[ \t]+\d+(\.\d+)?(min|m|s)/
+    )),
+
+    // Lifting tempo in seconds: tempo 3-1-1-0
+    // (eccentric-pause-concentric-pause)
+    tempo: ($) => /tempo[ \t]+\d+-\d+-\d+-\d+/,
+
+    // Mean bar velocity from a VBT device, in m/s: v0.45
+    velocity: ($) => /v\d+(\.\d+)?/,
+
+    // Warmup marker: excludes the line's sets from working-set analyses
+    warmup: ($) => "warmup",
+
+    // Set-level tags in brackets: [paused] or [paused, tempo]
+    set_tags: ($) => /\[[^\[\]\n]*\]/,
 
     // ISO 8601 duration: PT followed by at least one component
     // Examples: PT30M, PT30M15S, PT1H, PT1H30M, PT1H30M15S, PT30M15.5S
@@ -6,71 +6,99 @@
 #pragma GCC diagnostic ignored "-Wmissing-field-initializers"
 #endif
 
+#ifdef _MSC_VER
+#pragma optimize("", off)
+#elif defined(__clang__)
+#pragma clang optimize off
+#elif defined(__GNUC__)
+#pragma GCC optimize ("O0")
+#endif
+
 #define LANGUAGE_VERSION 15
-#define STATE_COUNT 91
+#define STATE_COUNT 157
 #define LARGE_STATE_COUNT 2
-#define SYMBOL_COUNT 52
+#define SYMBOL_COUNT 72
 #define ALIAS_COUNT 0
-#define TOKEN_COUNT 27
+#define TOKEN_COUNT 40
 #define EXTERNAL_TOKEN_COUNT 0
-#define FIELD_COUNT 17
+#define FIELD_COUNT 28
 #define MAX_ALIAS_SEQUENCE_LENGTH 9
 #define MAX_RESERVED_WORD_SET_SIZE 0
-#define PRODUCTION_ID_COUNT 23
+#define PRODUCTION_ID_COUNT 39
 #define SUPERTYPE_COUNT 0
 
 enum ts_symbol_identifiers {
   anon_sym_LF = 1,
   anon_sym_ATinclude = 2,
   anon_sym_ATplugin = 3,
-  anon_sym_DQUOTE = 4,
-  aux_sym_file_path_token1 = 5,
-  sym_comment = 6,
-  anon_sym_COLON = 7,
-  anon_sym_note = 8,
-  anon_sym_W = 9,
-  anon_sym_query = 10,
-  anon_sym_ATsession = 11,
-  anon_sym_ATend = 12,
-  anon_sym_ATmovement = 13,
-  anon_sym_ATtemplate = 14,
-  anon_sym_note_COLON = 15,
-  sym_date = 16,
-  anon_sym_STAR = 17,
-  anon_sym_BANG = 18,
-  aux_sym_item_token1 = 19,
-  aux_sym_name_token1 = 20,
-  sym_weight = 21,
-  sym_rep_scheme = 22,
-  sym_duration = 23,
-  sym_time_of_day = 24,
-  sym_distance = 25,
-  sym_quoted_string = 26,
-  sym_source_file = 27,
-  sym__entry = 28,
-  sym_include_directive = 29,
-  sym_plugin_directive = 30,
-  sym_file_path = 31,
-  sym_singleline_entry = 32,
-  sym_note_entry = 33,
-  sym_weigh_in_entry = 34,
-  sym_query_entry = 35,
-  sym_session_block = 36,
-  sym_movement_block = 37,
-  sym_template_block = 38,
-  sym_item_line = 39,
-  sym_note_line = 40,
-  sym_metadata_line = 41,
-  sym_flag = 42,
-  sym_item = 43,
-  sym_identifier = 44,
-  sym_name = 45,
-  sym_text_until_newline = 46,
-  sym_details = 47,
-  aux_sym_source_file_repeat1 = 48,
-  aux_sym_session_block_repeat1 = 49,
-  aux_sym_movement_block_repeat1 = 50,
-  aux_sym_details_repeat1 = 51,
+  anon_sym_ATunits = 4,
+  anon_sym_ATbodyweight = 5,
+  anon_sym_ATgoal = 6,
+  anon_sym_DQUOTE = 7,
+  aux_sym_file_path_token1 = 8,
+  sym_comment = 9,
+  anon_sym_COLON = 10,
+  anon_sym_note = 11,
+  anon_sym_W = 12,
+  anon_sym_rest = 13,
+  anon_sym_query = 14,
+  anon_sym_ATsession = 15,
+  anon_sym_ATend = 16,
+  anon_sym_ATsuperset = 17,
+  anon_sym_ATcircuit = 18,
+  anon_sym_ATmovement = 19,
+  anon_sym_ATtemplate = 20,
+  anon_sym_note_COLON = 21,
+  sym_date = 22,
+  anon_sym_STAR = 23,
+  anon_sym_BANG = 24,
+  aux_sym_item_token1 = 25,
+  aux_sym_name_token1 = 26,
+  sym_weight = 27,
+  sym_percent = 28,
+  sym_rep_scheme = 29,
+  sym_effort = 30,
+  sym_rest = 31,
+  sym_tempo = 32,
+  sym_velocity = 33,
+  sym_warmup = 34,
+  sym_set_tags = 35,
+  sym_duration = 36,
+  sym_time_of_day = 37,
+  sym_distance = 38,
+  sym_quoted_string = 39,
+  sym_source_file = 40,
+  sym__entry = 41,
+  sym_include_directive = 42,
+  sym_plugin_directive = 43,
+  sym_units_directive = 44,
+  sym_bodyweight_directive = 45,
+  sym_goal_directive = 46,
+  sym_file_path = 47,
+  sym_singleline_entry = 48,
+  sym_note_entry = 49,
+  sym_weigh_in_entry = 50,
+  sym_rest_entry = 51,
+  sym_query_entry = 52,
+  sym_session_block = 53,
+  sym_group_block = 54,
+  sym_movement_block = 55,
+  sym_template_block = 56,
+  sym_item_line = 57,
+  sym_note_line = 58,
+  sym_metadata_line = 59,
+  sym_flag = 60,
+  sym_item = 61,
+  sym_identifier = 62,
+  sym_name = 63,
+  sym_text_until_newline = 64,
+  sym_details = 65,
+  aux_sym_source_file_repeat1 = 66,
+  aux_sym_session_block_repeat1 = 67,
+  aux_sym_group_block_repeat1 = 68,
+  aux_sym_movement_block_repeat1 = 69,
+  aux_sym_template_block_repeat1 = 70,
+  aux_sym_details_repeat1 = 71,
 };
 
 static const char * const ts_symbol_names[] = {
@@ -78,15 +106,21 @@ static const char * const ts_symbol_names[] = {
   [anon_sym_LF] = "\n",
   [anon_sym_ATinclude] = "@include",
   [anon_sym_ATplugin] = "@plugin",
+  [anon_sym_ATunits] = "@units",
+  [anon_sym_ATbodyweight] = "@bodyweight",
+  [anon_sym_ATgoal] = "@goal",
   [anon_sym_DQUOTE] = "\"",
   [aux_sym_file_path_token1] = "file_path_token1",
   [sym_comment] = "comment",
   [anon_sym_COLON] = ":",
   [anon_sym_note] = "note",
   [anon_sym_W] = "W",
+  [anon_sym_rest] = "rest",
   [anon_sym_query] = "query",
   [anon_sym_ATsession] = "@session",
   [anon_sym_ATend] = "@end",
+  [anon_sym_ATsuperset] = "@superset",
+  [anon_sym_ATcircuit] = "@circuit",
   [anon_sym_ATmovement] = "@movement",
   [anon_sym_ATtemplate] = "@template",
   [anon_sym_note_COLON] = "note:",
@@ -96,7 +130,14 @@ static const char * const ts_symbol_names[] = {
   [aux_sym_item_token1] = "item_token1",
   [aux_sym_name_token1] = "name_token1",
   [sym_weight] = "weight",
+  [sym_percent] = "percent",
   [sym_rep_scheme] = "rep_scheme",
+  [sym_effort] = "effort",
+  [sym_rest] = "rest",
+  [sym_tempo] = "tempo",
+  [sym_velocity] = "velocity",
+  [sym_warmup] = "warmup",
+  [sym_set_tags] = "set_tags",
   [sym_duration] = "duration",
   [sym_time_of_day] = "time_of_day",
   [sym_distance] = "distance",
@@ -105,12 +146,17 @@ static const char * const ts_symbol_names[] = {
   [sym__entry] = "_entry",
   [sym_include_directive] = "include_directive",
   [sym_plugin_directive] = "plugin_directive",
+  [sym_units_directive] = "units_directive",
+  [sym_bodyweight_directive] = "bodyweight_directive",
+  [sym_goal_directive] = "goal_directive",
   [sym_file_path] = "file_path",
   [sym_singleline_entry] = "singleline_entry",
   [sym_note_entry] = "note_entry",
   [sym_weigh_in_entry] = "weigh_in_entry",
+  [sym_rest_entry] = "rest_entry",
   [sym_query_entry] = "query_entry",
   [sym_session_block] = "session_block",
+  [sym_group_block] = "group_block",
   [sym_movement_block] = "movement_block",
   [sym_template_block] = "template_block",
   [sym_item_line] = "item_line",
@@ -124,7 +170,9 @@ static const char * const ts_symbol_names[] = {
   [sym_details] = "details",
   [aux_sym_source_file_repeat1] = "source_file_repeat1",
   [aux_sym_session_block_repeat1] = "session_block_repeat1",
+  [aux_sym_group_block_repeat1] = "group_block_repeat1",
   [aux_sym_movement_block_repeat1] = "movement_block_repeat1",
+  [aux_sym_template_block_repeat1] = "template_block_repeat1",
   [aux_sym_details_repeat1] = "details_repeat1",
 };
 
@@ -133,15 +181,21 @@ static const TSSymbol ts_symbol_map[] = {
   [anon_sym_LF] = anon_sym_LF,
   [anon_sym_ATinclude] = anon_sym_ATinclude,
   [anon_sym_ATplugin] = anon_sym_ATplugin,
+  [anon_sym_ATunits] = anon_sym_ATunits,
+  [anon_sym_ATbodyweight] = anon_sym_ATbodyweight,
+  [anon_sym_ATgoal] = anon_sym_ATgoal,
   [anon_sym_DQUOTE] = anon_sym_DQUOTE,
   [aux_sym_file_path_token1] = aux_sym_file_path_token1,
   [sym_comment] = sym_comment,
   [anon_sym_COLON] = anon_sym_COLON,
   [anon_sym_note] = anon_sym_note,
   [anon_sym_W] = anon_sym_W,
+  [anon_sym_rest] = anon_sym_rest,
   [anon_sym_query] = anon_sym_query,
   [anon_sym_ATsession] = anon_sym_ATsession,
   [anon_sym_ATend] = anon_sym_ATend,
+  [anon_sym_ATsuperset] = anon_sym_ATsuperset,
+  [anon_sym_ATcircuit] = anon_sym_ATcircuit,
   [anon_sym_ATmovement] = anon_sym_ATmovement,
   [anon_sym_ATtemplate] = anon_sym_ATtemplate,
   [anon_sym_note_COLON] = anon_sym_note_COLON,
@@ -151,7 +205,14 @@ static const TSSymbol ts_symbol_map[] = {
   [aux_sym_item_token1] = aux_sym_item_token1,
   [aux_sym_name_token1] = aux_sym_name_token1,
   [sym_weight] = sym_weight,
+  [sym_percent] = sym_percent,
   [sym_rep_scheme] = sym_rep_scheme,
+  [sym_effort] = sym_effort,
+  [sym_rest] = sym_rest,
+  [sym_tempo] = sym_tempo,
+  [sym_velocity] = sym_velocity,
+  [sym_warmup] = sym_warmup,
+  [sym_set_tags] = sym_set_tags,
   [sym_duration] = sym_duration,
   [sym_time_of_day] = sym_time_of_day,
   [sym_distance] = sym_distance,
@@ -160,12 +221,17 @@ static const TSSymbol ts_symbol_map[] = {
   [sym__entry] = sym__entry,
   [sym_include_directive] = sym_include_directive,
   [sym_plugin_directive] = sym_plugin_directive,
+  [sym_units_directive] = sym_units_directive,
+  [sym_bodyweight_directive] = sym_bodyweight_directive,
+  [sym_goal_directive] = sym_goal_directive,
   [sym_file_path] = sym_file_path,
   [sym_singleline_entry] = sym_singleline_entry,
   [sym_note_entry] = sym_note_entry,
   [sym_weigh_in_entry] = sym_weigh_in_entry,
+  [sym_rest_entry] = sym_rest_entry,
   [sym_query_entry] = sym_query_entry,
   [sym_session_block] = sym_session_block,
+  [sym_group_block] = sym_group_block,
   [sym_movement_block] = sym_movement_block,
   [sym_template_block] = sym_template_block,
   [sym_item_line] = sym_item_line,
@@ -179,7 +245,9 @@ static const TSSymbol ts_symbol_map[] = {
   [sym_details] = sym_details,
   [aux_sym_source_file_repeat1] = aux_sym_source_file_repeat1,
   [aux_sym_session_block_repeat1] = aux_sym_session_block_repeat1,
+  [aux_sym_group_block_repeat1] = aux_sym_group_block_repeat1,
   [aux_sym_movement_block_repeat1] = aux_sym_movement_block_repeat1,
+  [aux_sym_template_block_repeat1] = aux_sym_template_block_repeat1,
   [aux_sym_details_repeat1] = aux_sym_details_repeat1,
 };
 
@@ -200,6 +268,18 @@ static const TSSymbolMetadata ts_symbol_metadata[] = {
     .visible = true,
     .named = false,
   },
+  [anon_sym_ATunits] = {
+    .visible = true,
+    .named = false,
+  },
+  [anon_sym_ATbodyweight] = {
+    .visible = true,
+    .named = false,
+  },
+  [anon_sym_ATgoal] = {
+    .visible = true,
+    .named = false,
+  },
   [anon_sym_DQUOTE] = {
     .visible = true,
     .named = false,
@@ -224,6 +304,10 @@ static const TSSymbolMetadata ts_symbol_metadata[] = {
     .visible = true,
     .named = false,
   },
+  [anon_sym_rest] = {
+    .visible = true,
+    .named = false,
+  },
   [anon_sym_query] = {
     .visible = true,
     .named = false,
@@ -236,6 +320,14 @@ static const TSSymbolMetadata ts_symbol_metadata[] = {
     .visible = true,
     .named = false,
   },
+  [anon_sym_ATsuperset] = {
+    .visible = true,
+    .named = false,
+  },
+  [anon_sym_ATcircuit] = {
+    .visible = true,
+    .named = false,
+  },
   [anon_sym_ATmovement] = {
     .visible = true,
     .named = false,
@@ -272,10 +364,38 @@ static const TSSymbolMetadata ts_symbol_metadata[] = {
     .visible = true,
     .named = true,
   },
+  [sym_percent] = {
+    .visible = true,
+    .named = true,
+  },
   [sym_rep_scheme] = {
     .visible = true,
     .named = true,
   },
+  [sym_effort] = {
+    .visible = true,
+    .named = true,
+  },
+  [sym_rest] = {
+    .visible = true,
+    .named = true,
+  },
+  [sym_tempo] = {
+    .visible = true,
+    .named = true,
+  },
+  [sym_velocity] = {
+    .visible = true,
+    .named = true,
+  },
+  [sym_warmup] = {
+    .visible = true,
+    .named = true,
+  },
+  [sym_set_tags] = {
+    .visible = true,
+    .named = true,
+  },
   [sym_duration] = {
     .visible = true,
     .named = true,
@@ -308,6 +428,18 @@ static const TSSymbolMetadata ts_symbol_metadata[] = {
     .visible = true,
     .named = true,
   },
+  [sym_units_directive] = {
+    .visible = true,
+    .named = true,
+  },
+  [sym_bodyweight_directive] = {
+    .visible = true,
+    .named = true,
+  },
+  [sym_goal_directive] = {
+    .visible = true,
+    .named = true,
+  },
   [sym_file_path] = {
     .visible = true,
     .named = true,
@@ -324,6 +456,10 @@ static const TSSymbolMetadata ts_symbol_metadata[] = {
     .visible = true,
     .named = true,
   },
+  [sym_rest_entry] = {
+    .visible = true,
+    .named = true,
+  },
   [sym_query_entry] = {
     .visible = true,
     .named = true,
@@ -332,6 +468,10 @@ static const TSSymbolMetadata ts_symbol_metadata[] = {
     .visible = true,
     .named = true,
   },
+  [sym_group_block] = {
+    .visible = true,
+    .named = true,
+  },
   [sym_movement_block] = {
     .visible = true,
     .named = true,
@@ -384,10 +524,18 @@ static const TSSymbolMetadata ts_symbol_metadata[] = {
     .visible = false,
     .named = false,
   },
+  [aux_sym_group_block_repeat1] = {
+    .visible = false,
+    .named = false,
+  },
   [aux_sym_movement_block_repeat1] = {
     .visible = false,
     .named = false,
   },
+  [aux_sym_template_block_repeat1] = {
+    .visible = false,
+    .named = false,
+  },
   [aux_sym_details_repeat1] = {
     .visible = false,
     .named = false,
@@ -395,149 +543,251 @@ static const TSSymbolMetadata ts_symbol_metadata[] = {
 };
 
 enum ts_field_identifiers {
-  field_date = 1,
-  field_details = 2,
-  field_distance = 3,
-  field_duration = 4,
-  field_flag = 5,
-  field_item = 6,
-  field_key = 7,
-  field_name = 8,
-  field_note = 9,
-  field_path = 10,
-  field_rep_scheme = 11,
-  field_scale = 12,
-  field_sql = 13,
-  field_text = 14,
-  field_time_of_day = 15,
-  field_value = 16,
-  field_weight = 17,
+  field_comment = 1,
+  field_date = 2,
+  field_details = 3,
+  field_distance = 4,
+  field_duration = 5,
+  field_effort = 6,
+  field_flag = 7,
+  field_item = 8,
+  field_key = 9,
+  field_kind = 10,
+  field_movement = 11,
+  field_name = 12,
+  field_note = 13,
+  field_path = 14,
+  field_percent = 15,
+  field_rep_scheme = 16,
+  field_rest = 17,
+  field_scale = 18,
+  field_sql = 19,
+  field_tags = 20,
+  field_tempo = 21,
+  field_text = 22,
+  field_time_of_day = 23,
+  field_unit = 24,
+  field_value = 25,
+  field_velocity = 26,
+  field_warmup = 27,
+  field_weight = 28,
 };
 
 static const char * const ts_field_names[] = {
   [0] = NULL,
+  [field_comment] = "comment",
   [field_date] = "date",
   [field_details] = "details",
   [field_distance] = "distance",
   [field_duration] = "duration",
+  [field_effort] = "effort",
   [field_flag] = "flag",
   [field_item] = "item",
   [field_key] = "key",
+  [field_kind] = "kind",
+  [field_movement] = "movement",
   [field_name] = "name",
   [field_note] = "note",
   [field_path] = "path",
+  [field_percent] = "percent",
   [field_rep_scheme] = "rep_scheme",
+  [field_rest] = "rest",
   [field_scale] = "scale",
   [field_sql] = "sql",
+  [field_tags] = "tags",
+  [field_tempo] = "tempo",
   [field_text] = "text",
   [field_time_of_day] = "time_of_day",
+  [field_unit] = "unit",
   [field_value] = "value",
+  [field_velocity] = "velocity",
+  [field_warmup] = "warmup",
   [field_weight] = "weight",
 };
 
 static const TSMapSlice ts_field_map_slices[PRODUCTION_ID_COUNT] = {
   [1] = {.index = 0, .length = 1},
-  [2] = {.index = 1, .length = 2},
-  [3] = {.index = 3, .length = 2},
-  [4] = {.index = 5, .length = 1},
-  [5] = {.index = 6, .length = 3},
-  [6] = {.index = 9, .length = 3},
-  [7] = {.index = 12, .length = 3},
-  [8] = {.index = 15, .length = 3},
-  [9] = {.index = 18, .length = 4},
-  [10] = {.index = 22, .length = 1},
-  [11] = {.index = 23, .length = 1},
-  [12] = {.index = 24, .length = 1},
-  [13] = {.index = 25, .length = 1},
-  [14] = {.index = 26, .length = 1},
-  [15] = {.index = 27, .length = 4},
-  [16] = {.index = 31, .length = 5},
-  [17] = {.index = 36, .length = 1},
-  [18] = {.index = 37, .length = 1},
-  [19] = {.index = 38, .length = 10},
-  [20] = {.index = 48, .length = 3},
-  [21] = {.index = 51, .length = 2},
-  [22] = {.index = 53, .length = 2},
+  [2] = {.index = 1, .length = 1},
+  [3] = {.index = 2, .length = 1},
+  [4] = {.index = 3, .length = 1},
+  [5] = {.index = 4, .length = 2},
+  [6] = {.index = 6, .length = 2},
+  [7] = {.index = 8, .length = 2},
+  [8] = {.index = 10, .length = 2},
+  [9] = {.index = 12, .length = 1},
+  [10] = {.index = 13, .length = 3},
+  [11] = {.index = 16, .length = 3},
+  [12] = {.index = 19, .length = 3},
+  [13] = {.index = 22, .length = 3},
+  [14] = {.index = 25, .length = 4},
+  [15] = {.index = 29, .length = 4},
+  [16] = {.index = 33, .length = 1},
+  [17] = {.index = 34, .length = 1},
+  [18] = {.index = 35, .length = 1},
+  [19] = {.index = 36, .length = 1},
+  [20] = {.index = 37, .length = 1},
+  [21] = {.index = 38, .length = 1},
+  [22] = {.index = 39, .length = 1},
+  [23] = {.index = 40, .length = 1},
+  [24] = {.index = 41, .length = 1},
+  [25] = {.index = 42, .length = 1},
+  [26] = {.index = 43, .length = 1},
+  [27] = {.index = 44, .length = 1},
+  [28] = {.index = 45, .length = 4},
+  [29] = {.index = 49, .length = 12},
+  [30] = {.index = 61, .length = 1},
+  [31] = {.index = 62, .length = 1},
+  [32] = {.index = 63, .length = 5},
+  [33] = {.index = 68, .length = 24},
+  [34] = {.index = 92, .length = 3},
+  [35] = {.index = 95, .length = 2},
+  [36] = {.index = 97, .length = 2},
+  [37] = {.index = 99, .length = 3},
+  [38] = {.index = 102, .length = 1},
 };
 
 static const TSFieldMapEntry ts_field_map_entries[] = {
   [0] =
     {field_path, 1},
   [1] =
+    {field_unit, 1},
+  [2] =
+    {field_weight, 1},
+  [3] =
+    {field_date, 0},
+  [4] =
+    {field_movement, 1},
+    {field_weight, 2},
+  [6] =
     {field_date, 0},
     {field_text, 2},
-  [3] =
+  [8] =
     {field_date, 0},
     {field_weight, 2},
-  [5] =
+  [10] =
+    {field_date, 0},
+    {field_note, 2},
+  [12] =
     {field_name, 1},
-  [6] =
+  [13] =
     {field_date, 0},
     {field_time_of_day, 3},
     {field_weight, 2},
-  [9] =
+  [16] =
     {field_date, 0},
     {field_scale, 3},
     {field_weight, 2},
-  [12] =
+  [19] =
     {field_date, 0},
     {field_name, 2},
     {field_sql, 3},
-  [15] =
+  [22] =
     {field_date, 0},
     {field_flag, 1},
     {field_item, 2},
-  [18] =
+  [25] =
     {field_date, 0},
     {field_scale, 4},
     {field_time_of_day, 3},
     {field_weight, 2},
-  [22] =
+  [29] =
+    {field_comment, 4},
+    {field_date, 0},
+    {field_flag, 1},
+    {field_item, 2},
+  [33] =
     {field_weight, 0},
-  [23] =
+  [34] =
+    {field_percent, 0},
+  [35] =
     {field_rep_scheme, 0},
-  [24] =
+  [36] =
+    {field_effort, 0},
+  [37] =
+    {field_rest, 0},
+  [38] =
+    {field_tempo, 0},
+  [39] =
+    {field_velocity, 0},
+  [40] =
+    {field_warmup, 0},
+  [41] =
+    {field_tags, 0},
+  [42] =
     {field_duration, 0},
-  [25] =
+  [43] =
     {field_distance, 0},
-  [26] =
+  [44] =
     {field_note, 0},
-  [27] =
+  [45] =
     {field_date, 0},
     {field_details, 4},
     {field_flag, 1},
     {field_item, 2},
-  [31] =
+  [49] =
     {field_distance, 0, .inherited = true},
     {field_duration, 0, .inherited = true},
+    {field_effort, 0, .inherited = true},
     {field_note, 0, .inherited = true},
+    {field_percent, 0, .inherited = true},
     {field_rep_scheme, 0, .inherited = true},
+    {field_rest, 0, .inherited = true},
+    {field_tags, 0, .inherited = true},
+    {field_tempo, 0, .inherited = true},
+    {field_velocity, 0, .inherited = true},
+    {field_warmup, 0, .inherited = true},
     {field_weight, 0, .inherited = true},
-  [36] =
+  [61] =
     {field_key, 0},
-  [37] =
+  [62] =
     {field_text, 1},
-  [38] =
+  [63] =
+    {field_comment, 5},
+    {field_date, 0},
+    {field_details, 4},
+    {field_flag, 1},
+    {field_item, 2},
+  [68] =
     {field_distance, 0, .inherited = true},
     {field_distance, 1, .inherited = true},
     {field_duration, 0, .inherited = true},
     {field_duration, 1, .inherited = true},
+    {field_effort, 0, .inherited = true},
+    {field_effort, 1, .inherited = true},
     {field_note, 0, .inherited = true},
     {field_note, 1, .inherited = true},
+    {field_percent, 0, .inherited = true},
+    {field_percent, 1, .inherited = true},
     {field_rep_scheme, 0, .inherited = true},
     {field_rep_scheme, 1, .inherited = true},
+    {field_rest, 0, .inherited = true},
+    {field_rest, 1, .inherited = true},
+    {field_tags, 0, .inherited = true},
+    {field_tags, 1, .inherited = true},
+    {field_tempo, 0, .inherited = true},
+    {field_tempo, 1, .inherited = true},
+    {field_velocity, 0, .inherited = true},
+    {field_velocity, 1, .inherited = true},
+    {field_warmup, 0, .inherited = true},
+    {field_warmup, 1, .inherited = true},
     {field_weight, 0, .inherited = true},
     {field_weight, 1, .inherited = true},
-  [48] =
+  [92] =
     {field_date, 2},
     {field_flag, 3},
     {field_name, 4},
-  [51] =
+  [95] =
     {field_key, 0},
     {field_value, 2},
-  [53] =
+  [97] =
     {field_details, 2},
     {field_item, 0},
+  [99] =
+    {field_comment, 3},
+    {field_details, 2},
+    {field_item, 0},
+  [102] =
+    {field_kind, 0},
 };
 
 static const TSSymbol ts_alias_sequences[PRODUCTION_ID_COUNT][MAX_ALIAS_SEQUENCE_LENGTH] = {
@@ -568,24 +818,24 @@ static const TSStateId ts_primary_state_ids[STATE_COUNT] = {
   [16] = 16,
   [17] = 17,
   [18] = 18,
-  [19] = 19,
-  [20] = 20,
+  [19] = 5,
+  [20] = 6,
   [21] = 21,
   [22] = 22,
-  [23] = 23,
-  [24] = 24,
-  [25] = 25,
-  [26] = 26,
-  [27] = 27,
-  [28] = 28,
-  [29] = 29,
-  [30] = 30,
-  [31] = 31,
-  [32] = 32,
-  [33] = 33,
-  [34] = 34,
-  [35] = 35,
-  [36] = 36,
+  [23] = 11,
+  [24] = 8,
+  [25] = 13,
+  [26] = 12,
+  [27] = 15,
+  [28] = 16,
+  [29] = 17,
+  [30] = 9,
+  [31] = 14,
+  [32] = 7,
+  [33] = 18,
+  [34] = 10,
+  [35] = 21,
+  [36] = 21,
   [37] = 37,
   [38] = 38,
   [39] = 39,
@@ -639,7 +889,73 @@ static const TSStateId ts_primary_state_ids[STATE_COUNT] = {
   [87] = 87,
   [88] = 88,
   [89] = 89,
-  [90] = 77,
+  [90] = 90,
+  [91] = 91,
+  [92] = 92,
+  [93] = 93,
+  [94] = 94,
+  [95] = 95,
+  [96] = 96,
+  [97] = 97,
+  [98] = 98,
+  [99] = 99,
+  [100] = 100,
+  [101] = 101,
+  [102] = 87,
+  [103] = 86,
+  [104] = 104,
+  [105] = 93,
+  [106] = 106,
+  [107] = 107,
+  [108] = 108,
+  [109] = 109,
+  [110] = 110,
+  [111] = 111,
+  [112] = 112,
+  [113] = 113,
+  [114] = 114,
+  [115] = 115,
+  [116] = 116,
+  [117] = 86,
+  [118] = 87,
+  [119] = 108,
+  [120] = 108,
+  [121] = 38,
+  [122] = 122,
+  [123] = 123,
+  [124] = 124,
+  [125] = 125,
+  [126] = 126,
+  [127] = 127,
+  [128] = 128,
+  [129] = 129,
+  [130] = 126,
+  [131] = 131,
+  [132] = 132,
+  [133] = 133,
+  [134] = 134,
+  [135] = 135,
+  [136] = 136,
+  [137] = 137,
+  [138] = 138,
+  [139] = 139,
+  [140] = 140,
+  [141] = 141,
+  [142] = 142,
+  [143] = 143,
+  [144] = 144,
+  [145] = 145,
+  [146] = 143,
+  [147] = 147,
+  [148] = 138,
+  [149] = 149,
+  [150] = 138,
+  [151] = 145,
+  [152] = 152,
+  [153] = 153,
+  [154] = 140,
+  [155] = 140,
+  [156] = 156,
 };
 
 static bool ts_lex(TSLexer *lexer, TSStateId state) {
@@ -647,1088 +963,1898 @@ static bool ts_lex(TSLexer *lexer, TSStateId state) {
   eof = lexer->eof(lexer);
   switch (state) {
     case 0:
-      if (eof) ADVANCE(188);
+      if (eof) ADVANCE(322);
       ADVANCE_MAP(
-        '\n', 189,
-        '!', 209,
-        '"', 192,
-        '#', 195,
-        '*', 208,
-        ':', 196,
-        '@', 49,
-        'B', 23,
-        'P', 22,
-        'T', 183,
-        'W', 199,
-        'n', 118,
-        'q', 160,
+        '\n', 323,
+        '!', 352,
+        '"', 329,
+        '#', 333,
+        '*', 351,
+        ':', 334,
+        '@', 74,
+        'A', 38,
+        'B', 52,
+        'P', 50,
+        'T', 310,
+        'W', 337,
+        '[', 55,
+        'b', 285,
+        'n', 191,
+        'q', 260,
+        'r', 94,
+        't', 95,
+        'v', 286,
+        'w', 56,
       );
       if (lookahead == '\t' ||
           lookahead == ' ') SKIP(0);
-      if (('0' <= lookahead && lookahead <= '9')) ADVANCE(7);
+      if (('0' <= lookahead && lookahead <= '9')) ADVANCE(379);
       END_STATE();
     case 1:
-      if (lookahead == '\n') ADVANCE(189);
+      ADVANCE_MAP(
+        '\n', 323,
+        '"', 8,
+        '#', 333,
+        '@', 283,
+        'A', 38,
+        'B', 52,
+        'P', 50,
+        '[', 55,
+        'b', 285,
+        'r', 115,
+        't', 95,
+        'v', 286,
+        'w', 56,
+      );
       if (lookahead == '\t' ||
-          lookahead == ' ') ADVANCE(218);
-      if (lookahead != 0) ADVANCE(219);
+          lookahead == ' ') SKIP(1);
+      if (('0' <= lookahead && lookahead <= '9')) ADVANCE(379);
       END_STATE();
     case 2:
-      if (lookahead == '"') ADVANCE(242);
-      if (lookahead != 0) ADVANCE(2);
+      if (lookahead == '\n') ADVANCE(323);
+      if (lookahead == '#') ADVANCE(332);
+      if (lookahead == '@') ADVANCE(354);
+      if (lookahead == 'n') ADVANCE(364);
+      if (lookahead == '\t' ||
+          lookahead == ' ') SKIP(2);
+      if (lookahead != 0 &&
+          (lookahead < '\t' || '\r' < lookahead) &&
+          lookahead != ':') ADVANCE(374);
       END_STATE();
     case 3:
-      ADVANCE_MAP(
-        '-', 185,
-        '.', 174,
-        '/', 18,
-        'c', 24,
-        'f', 127,
-        'g', 224,
-        'i', 100,
-        'k', 68,
-        'l', 36,
-        'm', 240,
-        'n', 91,
-        'o', 162,
-        'p', 122,
-        's', 151,
-        't', 223,
-        'x', 175,
-        'y', 25,
-      );
-      if (('0' <= lookahead && lookahead <= '9')) ADVANCE(8);
+      if (lookahead == '\n') ADVANCE(323);
+      if (lookahead == '\t' ||
+          lookahead == ' ') ADVANCE(375);
+      if (lookahead != 0) ADVANCE(376);
       END_STATE();
     case 4:
-      if (lookahead == '-') ADVANCE(186);
+      if (lookahead == ' ') ADVANCE(301);
       END_STATE();
     case 5:
-      ADVANCE_MAP(
-        '.', 174,
-        '/', 18,
-        'c', 24,
-        'f', 127,
-        'g', 224,
-        'i', 100,
-        'k', 68,
-        'l', 36,
-        'm', 240,
-        'n', 91,
-        'o', 162,
-        'p', 122,
-        's', 151,
-        't', 223,
-        'x', 175,
-        'y', 25,
-      );
-      if (('0' <= lookahead && lookahead <= '9')) ADVANCE(3);
+      if (lookahead == ' ') ADVANCE(318);
       END_STATE();
     case 6:
-      ADVANCE_MAP(
-        '.', 174,
-        '/', 18,
-        'c', 24,
-        'f', 127,
-        'g', 224,
-        'i', 100,
-        'k', 68,
-        'l', 36,
-        'm', 240,
-        'n', 91,
-        'o', 162,
-        'p', 122,
-        's', 151,
-        't', 223,
-        'x', 175,
-        'y', 25,
-      );
+      if (lookahead == ' ') ADVANCE(318);
       if (('0' <= lookahead && lookahead <= '9')) ADVANCE(5);
       END_STATE();
     case 7:
-      ADVANCE_MAP(
-        '.', 174,
-        '/', 18,
-        'c', 24,
-        'f', 127,
-        'g', 224,
-        'i', 100,
-        'k', 68,
-        'l', 36,
-        'm', 240,
-        'n', 91,
-        'o', 162,
-        'p', 122,
-        's', 151,
-        't', 223,
-        'x', 175,
-        'y', 25,
-      );
-      if (('0' <= lookahead && lookahead <= '9')) ADVANCE(6);
+      if (lookahead == '!') ADVANCE(352);
+      if (lookahead == '*') ADVANCE(351);
+      if (lookahead == 'W') ADVANCE(337);
+      if (lookahead == 'n') ADVANCE(206);
+      if (lookahead == 'q') ADVANCE(260);
+      if (lookahead == 'r') ADVANCE(94);
+      if (lookahead == '\t' ||
+          lookahead == ' ') SKIP(7);
       END_STATE();
     case 8:
-      ADVANCE_MAP(
-        '.', 174,
-        '/', 18,
-        'c', 24,
-        'f', 127,
-        'g', 224,
-        'i', 100,
-        'k', 68,
-        'l', 36,
-        'm', 240,
-        'n', 91,
-        'o', 162,
-        'p', 122,
-        's', 151,
-        't', 223,
-        'x', 175,
-        'y', 25,
-      );
-      if (('0' <= lookahead && lookahead <= '9')) ADVANCE(8);
+      if (lookahead == '"') ADVANCE(428);
+      if (lookahead != 0) ADVANCE(8);
       END_STATE();
     case 9:
-      if (lookahead == '.') ADVANCE(177);
-      if (lookahead == 'H') ADVANCE(235);
-      if (lookahead == 'M') ADVANCE(236);
-      if (lookahead == 'S') ADVANCE(234);
-      if (('0' <= lookahead && lookahead <= '9')) ADVANCE(9);
+      if (lookahead == '(') ADVANCE(293);
+      if (lookahead == 'A') ADVANCE(42);
+      if (('0' <= lookahead && lookahead <= '9')) ADVANCE(409);
       END_STATE();
     case 10:
-      if (lookahead == '.') ADVANCE(177);
-      if (lookahead == 'S') ADVANCE(234);
-      if (('0' <= lookahead && lookahead <= '9')) ADVANCE(10);
+      if (lookahead == '(') ADVANCE(308);
+      if (lookahead == '/') ADVANCE(36);
       END_STATE();
     case 11:
-      ADVANCE_MAP(
-        '.', 178,
-        '/', 19,
-        'c', 33,
-        'g', 231,
-        'k', 67,
-        'l', 35,
-        'o', 164,
-        'p', 131,
-        's', 157,
-        't', 230,
-      );
+      if (lookahead == ')') ADVANCE(403);
+      if (lookahead == '+') ADVANCE(298);
       if (('0' <= lookahead && lookahead <= '9')) ADVANCE(11);
       END_STATE();
     case 12:
-      ADVANCE_MAP(
-        '.', 180,
-        'c', 34,
-        'g', 227,
-        'k', 69,
-        'l', 37,
-        'o', 166,
-        'p', 132,
-        's', 158,
-        't', 226,
-      );
+      if (lookahead == ')') ADVANCE(403);
       if (('0' <= lookahead && lookahead <= '9')) ADVANCE(12);
       END_STATE();
     case 13:
-      ADVANCE_MAP(
-        '/', 19,
-        'c', 24,
-        'f', 127,
-        'g', 224,
-        'i', 100,
-        'k', 68,
-        'l', 36,
-        'm', 240,
-        'n', 91,
-        'o', 162,
-        'p', 122,
-        's', 151,
-        't', 223,
-        'y', 25,
-      );
+      if (lookahead == ')') ADVANCE(28);
       if (('0' <= lookahead && lookahead <= '9')) ADVANCE(13);
       END_STATE();
     case 14:
-      ADVANCE_MAP(
-        '/', 19,
-        'c', 33,
-        'g', 231,
-        'k', 67,
-        'l', 35,
-        'o', 164,
-        'p', 131,
-        's', 157,
-        't', 230,
-      );
+      if (lookahead == ')') ADVANCE(407);
       if (('0' <= lookahead && lookahead <= '9')) ADVANCE(14);
       END_STATE();
     case 15:
-      if (lookahead == ':') ADVANCE(184);
+      if (lookahead == '+') ADVANCE(298);
+      if (lookahead == '-') ADVANCE(299);
+      if (('0' <= lookahead && lookahead <= '9')) ADVANCE(15);
       END_STATE();
     case 16:
-      if (lookahead == '@') ADVANCE(212);
-      if (lookahead == 'n') ADVANCE(215);
-      if (lookahead == '\t' ||
-          lookahead == ' ') SKIP(16);
-      if (lookahead != 0 &&
-          (lookahead < '\t' || '\r' < lookahead) &&
-          lookahead != ':') ADVANCE(217);
+      if (lookahead == '-') ADVANCE(317);
+      if (('0' <= lookahead && lookahead <= '9')) ADVANCE(16);
       END_STATE();
     case 17:
-      if (lookahead == '@') ADVANCE(212);
-      if (lookahead == '\t' ||
-          lookahead == ' ') SKIP(17);
-      if (lookahead != 0 &&
-          (lookahead < '\t' || '\r' < lookahead) &&
-          lookahead != ':') ADVANCE(217);
+      if (lookahead == '-') ADVANCE(300);
+      if (('0' <= lookahead && lookahead <= '9')) ADVANCE(17);
       END_STATE();
     case 18:
-      if (lookahead == 'B') ADVANCE(23);
-      if (('0' <= lookahead && lookahead <= '9')) ADVANCE(232);
+      if (lookahead == '-') ADVANCE(315);
+      if (('0' <= lookahead && lookahead <= '9')) ADVANCE(18);
       END_STATE();
     case 19:
-      if (lookahead == 'B') ADVANCE(23);
-      if (('0' <= lookahead && lookahead <= '9')) ADVANCE(11);
+      if (lookahead == '-') ADVANCE(314);
       END_STATE();
     case 20:
-      if (lookahead == 'M') ADVANCE(236);
+      if (lookahead == '.') ADVANCE(294);
+      if (lookahead == 'H') ADVANCE(421);
+      if (lookahead == 'M') ADVANCE(422);
+      if (lookahead == 'S') ADVANCE(420);
       if (('0' <= lookahead && lookahead <= '9')) ADVANCE(20);
       END_STATE();
     case 21:
-      if (lookahead == 'S') ADVANCE(234);
+      if (lookahead == '.') ADVANCE(294);
+      if (lookahead == 'S') ADVANCE(420);
       if (('0' <= lookahead && lookahead <= '9')) ADVANCE(21);
       END_STATE();
     case 22:
-      if (lookahead == 'T') ADVANCE(172);
+      ADVANCE_MAP(
+        '.', 295,
+        '/', 37,
+        'c', 69,
+        'g', 398,
+        'k', 119,
+        'l', 72,
+        'o', 266,
+        'p', 208,
+        's', 255,
+        't', 397,
+      );
+      if (('0' <= lookahead && lookahead <= '9')) ADVANCE(22);
       END_STATE();
     case 23:
-      if (lookahead == 'W') ADVANCE(228);
+      ADVANCE_MAP(
+        '.', 296,
+        'c', 70,
+        'g', 401,
+        'k', 120,
+        'l', 73,
+        'o', 268,
+        'p', 210,
+        's', 257,
+        't', 400,
+      );
+      if (('0' <= lookahead && lookahead <= '9')) ADVANCE(23);
       END_STATE();
     case 24:
-      if (lookahead == 'a') ADVANCE(136);
-      if (lookahead == 'e') ADVANCE(106);
-      if (lookahead == 'm') ADVANCE(238);
-      if (lookahead == 't') ADVANCE(221);
+      ADVANCE_MAP(
+        '.', 307,
+        'c', 71,
+        'g', 394,
+        'k', 125,
+        'l', 77,
+        'o', 270,
+        'p', 211,
+        's', 258,
+        't', 393,
+      );
+      if (('0' <= lookahead && lookahead <= '9')) ADVANCE(24);
       END_STATE();
     case 25:
-      if (lookahead == 'a') ADVANCE(137);
-      if (lookahead == 'd') ADVANCE(238);
+      if (lookahead == '.') ADVANCE(303);
+      if (lookahead == 'm') ADVANCE(414);
+      if (lookahead == 's') ADVANCE(412);
+      if (('0' <= lookahead && lookahead <= '9')) ADVANCE(25);
       END_STATE();
     case 26:
-      if (lookahead == 'a') ADVANCE(90);
+      if (lookahead == '.') ADVANCE(305);
+      if (lookahead == 's') ADVANCE(412);
+      if (('0' <= lookahead && lookahead <= '9')) ADVANCE(26);
       END_STATE();
     case 27:
-      if (lookahead == 'a') ADVANCE(89);
+      ADVANCE_MAP(
+        '/', 37,
+        'c', 69,
+        'g', 398,
+        'k', 119,
+        'l', 72,
+        'o', 266,
+        'p', 208,
+        's', 255,
+        't', 397,
+      );
+      if (('0' <= lookahead && lookahead <= '9')) ADVANCE(27);
       END_STATE();
     case 28:
-      if (lookahead == 'a') ADVANCE(147);
+      if (lookahead == '/') ADVANCE(36);
       END_STATE();
     case 29:
-      if (lookahead == 'a') ADVANCE(93);
+      if (lookahead == ':') ADVANCE(312);
       END_STATE();
     case 30:
-      if (lookahead == 'a') ADVANCE(145);
+      if (lookahead == '@') ADVANCE(357);
+      if (lookahead == 'n') ADVANCE(364);
+      if (lookahead == '\t' ||
+          lookahead == ' ') SKIP(30);
+      if (lookahead != 0 &&
+          (lookahead < '\t' || '\r' < lookahead) &&
+          lookahead != ':') ADVANCE(374);
       END_STATE();
     case 31:
-      if (lookahead == 'a') ADVANCE(149);
+      if (lookahead == '@') ADVANCE(357);
+      if (lookahead == '\t' ||
+          lookahead == ' ') SKIP(31);
+      if (lookahead != 0 &&
+          (lookahead < '\t' || '\r' < lookahead) &&
+          lookahead != ':') ADVANCE(374);
       END_STATE();
     case 32:
-      if (lookahead == 'a') ADVANCE(154);
+      if (lookahead == 'A') ADVANCE(43);
       END_STATE();
     case 33:
-      if (lookahead == 'a') ADVANCE(139);
-      if (lookahead == 't') ADVANCE(228);
+      if (lookahead == 'A') ADVANCE(44);
       END_STATE();
     case 34:
-      if (lookahead == 'a') ADVANCE(141);
-      if (lookahead == 't') ADVANCE(220);
+      if (lookahead == 'A') ADVANCE(45);
       END_STATE();
     case 35:
-      if (lookahead == 'b') ADVANCE(228);
+      if (lookahead == 'A') ADVANCE(41);
+      if (lookahead == 'B') ADVANCE(54);
+      if (('0' <= lookahead && lookahead <= '9')) ADVANCE(406);
       END_STATE();
     case 36:
-      if (lookahead == 'b') ADVANCE(221);
+      if (lookahead == 'A') ADVANCE(41);
+      if (('0' <= lookahead && lookahead <= '9')) ADVANCE(408);
       END_STATE();
     case 37:
-      if (lookahead == 'b') ADVANCE(220);
+      if (lookahead == 'B') ADVANCE(54);
+      if (('0' <= lookahead && lookahead <= '9')) ADVANCE(22);
       END_STATE();
     case 38:
-      ADVANCE_MAP(
-        'c', 34,
-        'g', 227,
-        'k', 69,
-        'l', 37,
-        'o', 166,
-        'p', 132,
-        's', 158,
-        't', 226,
-      );
-      if (('0' <= lookahead && lookahead <= '9')) ADVANCE(38);
+      if (lookahead == 'M') ADVANCE(46);
       END_STATE();
     case 39:
-      if (lookahead == 'c') ADVANCE(55);
+      if (lookahead == 'M') ADVANCE(46);
+      if (('a' <= lookahead && lookahead <= 'z')) ADVANCE(319);
       END_STATE();
     case 40:
-      if (lookahead == 'c') ADVANCE(50);
+      if (lookahead == 'M') ADVANCE(422);
+      if (('0' <= lookahead && lookahead <= '9')) ADVANCE(40);
       END_STATE();
     case 41:
-      if (lookahead == 'c') ADVANCE(57);
+      if (lookahead == 'M') ADVANCE(47);
       END_STATE();
     case 42:
-      if (lookahead == 'c') ADVANCE(85);
+      if (lookahead == 'M') ADVANCE(48);
       END_STATE();
     case 43:
-      if (lookahead == 'd') ADVANCE(228);
+      if (lookahead == 'P') ADVANCE(10);
       END_STATE();
     case 44:
-      if (lookahead == 'd') ADVANCE(238);
+      if (lookahead == 'P') ADVANCE(405);
       END_STATE();
     case 45:
-      if (lookahead == 'd') ADVANCE(221);
+      if (lookahead == 'P') ADVANCE(404);
       END_STATE();
     case 46:
-      if (lookahead == 'd') ADVANCE(202);
+      if (lookahead == 'R') ADVANCE(32);
       END_STATE();
     case 47:
-      if (lookahead == 'd') ADVANCE(220);
+      if (lookahead == 'R') ADVANCE(33);
       END_STATE();
     case 48:
-      if (lookahead == 'd') ADVANCE(58);
+      if (lookahead == 'R') ADVANCE(34);
       END_STATE();
     case 49:
-      if (lookahead == 'e') ADVANCE(97);
-      if (lookahead == 'i') ADVANCE(98);
-      if (lookahead == 'm') ADVANCE(119);
-      if (lookahead == 'p') ADVANCE(83);
-      if (lookahead == 's') ADVANCE(51);
-      if (lookahead == 't') ADVANCE(61);
+      if (lookahead == 'S') ADVANCE(420);
+      if (('0' <= lookahead && lookahead <= '9')) ADVANCE(49);
       END_STATE();
     case 50:
-      if (lookahead == 'e') ADVANCE(228);
+      if (lookahead == 'T') ADVANCE(287);
       END_STATE();
     case 51:
-      if (lookahead == 'e') ADVANCE(143);
+      if (lookahead == 'T') ADVANCE(287);
+      if (('a' <= lookahead && lookahead <= 'z')) ADVANCE(319);
       END_STATE();
     case 52:
-      if (lookahead == 'e') ADVANCE(135);
+      if (lookahead == 'W') ADVANCE(385);
+      if (lookahead == 'w') ADVANCE(384);
       END_STATE();
     case 53:
-      if (lookahead == 'e') ADVANCE(238);
+      if (lookahead == 'W') ADVANCE(385);
+      if (lookahead == 'w') ADVANCE(386);
+      if (('a' <= lookahead && lookahead <= 'z')) ADVANCE(319);
       END_STATE();
     case 54:
-      if (lookahead == 'e') ADVANCE(238);
-      if (lookahead == 'l') ADVANCE(81);
+      if (lookahead == 'W') ADVANCE(395);
       END_STATE();
     case 55:
-      if (lookahead == 'e') ADVANCE(221);
+      if (lookahead == ']') ADVANCE(419);
+      if (lookahead != 0 &&
+          lookahead != '\n' &&
+          lookahead != '[') ADVANCE(55);
       END_STATE();
     case 56:
-      if (lookahead == 'e') ADVANCE(198);
+      if (lookahead == 'a') ADVANCE(220);
       END_STATE();
     case 57:
-      if (lookahead == 'e') ADVANCE(220);
+      if (lookahead == 'a') ADVANCE(143);
       END_STATE();
     case 58:
-      if (lookahead == 'e') ADVANCE(190);
+      if (lookahead == 'a') ADVANCE(219);
+      if (lookahead == 'e') ADVANCE(173);
+      if (lookahead == 'm') ADVANCE(424);
+      if (lookahead == 't') ADVANCE(388);
       END_STATE();
     case 59:
-      if (lookahead == 'e') ADVANCE(205);
+      if (lookahead == 'a') ADVANCE(160);
       END_STATE();
     case 60:
-      if (lookahead == 'e') ADVANCE(197);
+      if (lookahead == 'a') ADVANCE(221);
+      if (lookahead == 'd') ADVANCE(424);
       END_STATE();
     case 61:
-      if (lookahead == 'e') ADVANCE(92);
+      if (lookahead == 'a') ADVANCE(151);
       END_STATE();
     case 62:
-      if (lookahead == 'e') ADVANCE(94);
+      if (lookahead == 'a') ADVANCE(152);
       END_STATE();
     case 63:
-      if (lookahead == 'e') ADVANCE(134);
+      if (lookahead == 'a') ADVANCE(252);
       END_STATE();
     case 64:
-      if (lookahead == 'e') ADVANCE(134);
-      if (lookahead == 'r') ADVANCE(53);
+      if (lookahead == 'a') ADVANCE(161);
       END_STATE();
     case 65:
-      if (lookahead == 'e') ADVANCE(111);
+      if (lookahead == 'a') ADVANCE(239);
       END_STATE();
     case 66:
-      if (lookahead == 'e') ADVANCE(155);
+      if (lookahead == 'a') ADVANCE(241);
       END_STATE();
     case 67:
-      if (lookahead == 'g') ADVANCE(228);
-      if (lookahead == 'i') ADVANCE(87);
+      if (lookahead == 'a') ADVANCE(253);
       END_STATE();
     case 68:
-      if (lookahead == 'g') ADVANCE(221);
-      if (lookahead == 'i') ADVANCE(86);
-      if (lookahead == 'm') ADVANCE(238);
+      if (lookahead == 'a') ADVANCE(250);
       END_STATE();
     case 69:
-      if (lookahead == 'g') ADVANCE(220);
-      if (lookahead == 'i') ADVANCE(88);
+      if (lookahead == 'a') ADVANCE(224);
+      if (lookahead == 't') ADVANCE(395);
       END_STATE();
     case 70:
-      if (lookahead == 'g') ADVANCE(78);
+      if (lookahead == 'a') ADVANCE(226);
+      if (lookahead == 't') ADVANCE(377);
       END_STATE();
     case 71:
-      if (lookahead == 'g') ADVANCE(138);
-      if (lookahead == 'm') ADVANCE(66);
+      if (lookahead == 'a') ADVANCE(228);
+      if (lookahead == 't') ADVANCE(387);
       END_STATE();
     case 72:
-      if (lookahead == 'g') ADVANCE(140);
+      if (lookahead == 'b') ADVANCE(395);
       END_STATE();
     case 73:
-      if (lookahead == 'g') ADVANCE(142);
+      if (lookahead == 'b') ADVANCE(377);
       END_STATE();
     case 74:
-      if (lookahead == 'h') ADVANCE(238);
+      ADVANCE_MAP(
+        'b', 192,
+        'c', 132,
+        'e', 170,
+        'g', 196,
+        'i', 162,
+        'm', 193,
+        'p', 144,
+        's', 105,
+        't', 116,
+        'u', 169,
+        'R', 282,
+        'r', 282,
+      );
+      if (('0' <= lookahead && lookahead <= '9')) ADVANCE(410);
       END_STATE();
     case 75:
-      if (lookahead == 'i') ADVANCE(238);
+      ADVANCE_MAP(
+        'b', 192,
+        'g', 196,
+        'i', 162,
+        'm', 193,
+        'p', 144,
+        's', 104,
+        't', 116,
+        'u', 169,
+        'R', 282,
+        'r', 282,
+      );
+      if (('0' <= lookahead && lookahead <= '9')) ADVANCE(410);
       END_STATE();
     case 76:
-      if (lookahead == 'i') ADVANCE(98);
-      if (lookahead == 'm') ADVANCE(119);
-      if (lookahead == 'p') ADVANCE(83);
-      if (lookahead == 's') ADVANCE(51);
-      if (lookahead == 't') ADVANCE(61);
+      if (lookahead == 'b') ADVANCE(388);
       END_STATE();
     case 77:
-      if (lookahead == 'i') ADVANCE(99);
-      if (lookahead == 'm') ADVANCE(221);
+      if (lookahead == 'b') ADVANCE(387);
       END_STATE();
     case 78:
-      if (lookahead == 'i') ADVANCE(102);
+      ADVANCE_MAP(
+        'c', 70,
+        'g', 401,
+        'k', 120,
+        'l', 73,
+        'o', 268,
+        'p', 210,
+        's', 257,
+        't', 400,
+      );
+      if (('0' <= lookahead && lookahead <= '9')) ADVANCE(78);
       END_STATE();
     case 79:
-      if (lookahead == 'i') ADVANCE(96);
-      if (lookahead == 'm') ADVANCE(228);
+      ADVANCE_MAP(
+        'c', 71,
+        'g', 394,
+        'k', 125,
+        'l', 77,
+        'o', 270,
+        'p', 211,
+        's', 258,
+        't', 393,
+      );
+      if (('0' <= lookahead && lookahead <= '9')) ADVANCE(79);
       END_STATE();
     case 80:
-      if (lookahead == 'i') ADVANCE(101);
-      if (lookahead == 'm') ADVANCE(220);
+      if (lookahead == 'c') ADVANCE(265);
       END_STATE();
     case 81:
-      if (lookahead == 'i') ADVANCE(95);
+      if (lookahead == 'c') ADVANCE(113);
       END_STATE();
     case 82:
-      if (lookahead == 'i') ADVANCE(126);
+      if (lookahead == 'c') ADVANCE(99);
       END_STATE();
     case 83:
-      if (lookahead == 'l') ADVANCE(159);
+      if (lookahead == 'c') ADVANCE(100);
       END_STATE();
     case 84:
-      if (lookahead == 'l') ADVANCE(32);
+      if (lookahead == 'c') ADVANCE(114);
       END_STATE();
     case 85:
-      if (lookahead == 'l') ADVANCE(161);
+      if (lookahead == 'c') ADVANCE(146);
       END_STATE();
     case 86:
-      if (lookahead == 'l') ADVANCE(120);
+      if (lookahead == 'd') ADVANCE(424);
       END_STATE();
     case 87:
-      if (lookahead == 'l') ADVANCE(121);
+      if (lookahead == 'd') ADVANCE(274);
       END_STATE();
     case 88:
-      if (lookahead == 'l') ADVANCE(130);
+      if (lookahead == 'd') ADVANCE(341);
       END_STATE();
     case 89:
-      if (lookahead == 'm') ADVANCE(228);
+      if (lookahead == 'd') ADVANCE(395);
       END_STATE();
     case 90:
-      if (lookahead == 'm') ADVANCE(221);
+      if (lookahead == 'd') ADVANCE(377);
       END_STATE();
     case 91:
-      if (lookahead == 'm') ADVANCE(75);
+      if (lookahead == 'd') ADVANCE(101);
       END_STATE();
     case 92:
-      if (lookahead == 'm') ADVANCE(133);
+      if (lookahead == 'd') ADVANCE(388);
       END_STATE();
     case 93:
-      if (lookahead == 'm') ADVANCE(220);
+      if (lookahead == 'd') ADVANCE(387);
       END_STATE();
     case 94:
-      if (lookahead == 'm') ADVANCE(65);
+      if (lookahead == 'e') ADVANCE(232);
       END_STATE();
     case 95:
-      if (lookahead == 'm') ADVANCE(66);
+      if (lookahead == 'e') ADVANCE(154);
       END_STATE();
     case 96:
-      if (lookahead == 'n') ADVANCE(228);
+      if (lookahead == 'e') ADVANCE(424);
       END_STATE();
     case 97:
-      if (lookahead == 'n') ADVANCE(46);
+      if (lookahead == 'e') ADVANCE(424);
+      if (lookahead == 'l') ADVANCE(141);
       END_STATE();
     case 98:
-      if (lookahead == 'n') ADVANCE(42);
+      if (lookahead == 'e') ADVANCE(336);
       END_STATE();
     case 99:
-      if (lookahead == 'n') ADVANCE(221);
+      if (lookahead == 'e') ADVANCE(395);
       END_STATE();
     case 100:
-      if (lookahead == 'n') ADVANCE(239);
+      if (lookahead == 'e') ADVANCE(377);
       END_STATE();
     case 101:
-      if (lookahead == 'n') ADVANCE(220);
+      if (lookahead == 'e') ADVANCE(324);
       END_STATE();
     case 102:
-      if (lookahead == 'n') ADVANCE(191);
+      if (lookahead == 'e') ADVANCE(348);
       END_STATE();
     case 103:
-      if (lookahead == 'n') ADVANCE(201);
+      if (lookahead == 'e') ADVANCE(335);
       END_STATE();
     case 104:
-      if (lookahead == 'n') ADVANCE(39);
+      if (lookahead == 'e') ADVANCE(233);
       END_STATE();
     case 105:
-      if (lookahead == 'n') ADVANCE(45);
+      if (lookahead == 'e') ADVANCE(233);
+      if (lookahead == 'u') ADVANCE(215);
       END_STATE();
     case 106:
-      if (lookahead == 'n') ADVANCE(153);
+      if (lookahead == 'e') ADVANCE(218);
       END_STATE();
     case 107:
-      if (lookahead == 'n') ADVANCE(43);
+      if (lookahead == 'e') ADVANCE(158);
       END_STATE();
     case 108:
-      if (lookahead == 'n') ADVANCE(55);
+      if (lookahead == 'e') ADVANCE(223);
       END_STATE();
     case 109:
-      if (lookahead == 'n') ADVANCE(47);
+      if (lookahead == 'e') ADVANCE(133);
       END_STATE();
     case 110:
-      if (lookahead == 'n') ADVANCE(108);
+      if (lookahead == 'e') ADVANCE(216);
       END_STATE();
     case 111:
-      if (lookahead == 'n') ADVANCE(150);
+      if (lookahead == 'e') ADVANCE(216);
+      if (lookahead == 'r') ADVANCE(96);
       END_STATE();
     case 112:
-      if (lookahead == 'n') ADVANCE(113);
+      if (lookahead == 'e') ADVANCE(244);
       END_STATE();
     case 113:
-      if (lookahead == 'n') ADVANCE(50);
+      if (lookahead == 'e') ADVANCE(388);
       END_STATE();
     case 114:
-      if (lookahead == 'n') ADVANCE(57);
+      if (lookahead == 'e') ADVANCE(387);
       END_STATE();
     case 115:
-      if (lookahead == 'n') ADVANCE(40);
+      if (lookahead == 'e') ADVANCE(236);
       END_STATE();
     case 116:
-      if (lookahead == 'n') ADVANCE(114);
+      if (lookahead == 'e') ADVANCE(156);
       END_STATE();
     case 117:
-      if (lookahead == 'n') ADVANCE(41);
+      if (lookahead == 'e') ADVANCE(180);
       END_STATE();
     case 118:
-      if (lookahead == 'o') ADVANCE(152);
+      if (lookahead == 'e') ADVANCE(256);
       END_STATE();
     case 119:
-      if (lookahead == 'o') ADVANCE(168);
+      if (lookahead == 'g') ADVANCE(395);
+      if (lookahead == 'i') ADVANCE(148);
       END_STATE();
     case 120:
-      if (lookahead == 'o') ADVANCE(71);
+      if (lookahead == 'g') ADVANCE(377);
+      if (lookahead == 'i') ADVANCE(149);
       END_STATE();
     case 121:
-      if (lookahead == 'o') ADVANCE(72);
+      if (lookahead == 'g') ADVANCE(130);
       END_STATE();
     case 122:
-      if (lookahead == 'o') ADVANCE(163);
+      if (lookahead == 'g') ADVANCE(137);
       END_STATE();
     case 123:
-      if (lookahead == 'o') ADVANCE(146);
+      if (lookahead == 'g') ADVANCE(222);
+      if (lookahead == 'm') ADVANCE(118);
       END_STATE();
     case 124:
-      if (lookahead == 'o') ADVANCE(108);
+      if (lookahead == 'g') ADVANCE(388);
+      if (lookahead == 'i') ADVANCE(147);
+      if (lookahead == 'm') ADVANCE(424);
       END_STATE();
     case 125:
-      if (lookahead == 'o') ADVANCE(113);
+      if (lookahead == 'g') ADVANCE(387);
+      if (lookahead == 'i') ADVANCE(150);
       END_STATE();
     case 126:
-      if (lookahead == 'o') ADVANCE(103);
+      if (lookahead == 'g') ADVANCE(225);
       END_STATE();
     case 127:
-      if (lookahead == 'o') ADVANCE(123);
-      if (lookahead == 't') ADVANCE(238);
+      if (lookahead == 'g') ADVANCE(227);
       END_STATE();
     case 128:
-      if (lookahead == 'o') ADVANCE(114);
+      if (lookahead == 'g') ADVANCE(229);
       END_STATE();
     case 129:
-      if (lookahead == 'o') ADVANCE(156);
+      if (lookahead == 'h') ADVANCE(424);
       END_STATE();
     case 130:
-      if (lookahead == 'o') ADVANCE(73);
+      if (lookahead == 'h') ADVANCE(245);
       END_STATE();
     case 131:
-      if (lookahead == 'o') ADVANCE(165);
+      if (lookahead == 'i') ADVANCE(424);
       END_STATE();
     case 132:
-      if (lookahead == 'o') ADVANCE(167);
+      if (lookahead == 'i') ADVANCE(217);
       END_STATE();
     case 133:
-      if (lookahead == 'p') ADVANCE(84);
+      if (lookahead == 'i') ADVANCE(121);
       END_STATE();
     case 134:
-      if (lookahead == 'r') ADVANCE(238);
+      if (lookahead == 'i') ADVANCE(247);
       END_STATE();
     case 135:
-      if (lookahead == 'r') ADVANCE(169);
+      if (lookahead == 'i') ADVANCE(184);
+      if (lookahead == 'm') ADVANCE(388);
       END_STATE();
     case 136:
-      if (lookahead == 'r') ADVANCE(28);
+      if (lookahead == 'i') ADVANCE(242);
       END_STATE();
     case 137:
-      if (lookahead == 'r') ADVANCE(44);
+      if (lookahead == 'i') ADVANCE(166);
       END_STATE();
     case 138:
-      if (lookahead == 'r') ADVANCE(26);
+      if (lookahead == 'i') ADVANCE(164);
+      if (lookahead == 'm') ADVANCE(395);
       END_STATE();
     case 139:
-      if (lookahead == 'r') ADVANCE(30);
+      if (lookahead == 'i') ADVANCE(165);
+      if (lookahead == 'm') ADVANCE(377);
       END_STATE();
     case 140:
-      if (lookahead == 'r') ADVANCE(27);
+      if (lookahead == 'i') ADVANCE(185);
+      if (lookahead == 'm') ADVANCE(387);
       END_STATE();
     case 141:
-      if (lookahead == 'r') ADVANCE(31);
+      if (lookahead == 'i') ADVANCE(159);
       END_STATE();
     case 142:
-      if (lookahead == 'r') ADVANCE(29);
+      if (lookahead == 'i') ADVANCE(202);
       END_STATE();
     case 143:
-      if (lookahead == 's') ADVANCE(144);
+      if (lookahead == 'l') ADVANCE(328);
       END_STATE();
     case 144:
-      if (lookahead == 's') ADVANCE(82);
+      if (lookahead == 'l') ADVANCE(259);
       END_STATE();
     case 145:
-      if (lookahead == 't') ADVANCE(228);
+      if (lookahead == 'l') ADVANCE(68);
       END_STATE();
     case 146:
-      if (lookahead == 't') ADVANCE(238);
+      if (lookahead == 'l') ADVANCE(262);
       END_STATE();
     case 147:
-      if (lookahead == 't') ADVANCE(221);
+      if (lookahead == 'l') ADVANCE(195);
       END_STATE();
     case 148:
-      if (lookahead == 't') ADVANCE(64);
+      if (lookahead == 'l') ADVANCE(197);
       END_STATE();
     case 149:
-      if (lookahead == 't') ADVANCE(220);
+      if (lookahead == 'l') ADVANCE(207);
       END_STATE();
     case 150:
-      if (lookahead == 't') ADVANCE(204);
+      if (lookahead == 'l') ADVANCE(209);
       END_STATE();
     case 151:
-      if (lookahead == 't') ADVANCE(124);
+      if (lookahead == 'm') ADVANCE(395);
       END_STATE();
     case 152:
-      if (lookahead == 't') ADVANCE(56);
+      if (lookahead == 'm') ADVANCE(377);
       END_STATE();
     case 153:
-      if (lookahead == 't') ADVANCE(81);
+      if (lookahead == 'm') ADVANCE(413);
+      if (lookahead == 's') ADVANCE(412);
+      if (('0' <= lookahead && lookahead <= '9')) ADVANCE(153);
       END_STATE();
     case 154:
-      if (lookahead == 't') ADVANCE(59);
+      if (lookahead == 'm') ADVANCE(214);
       END_STATE();
     case 155:
-      if (lookahead == 't') ADVANCE(63);
+      if (lookahead == 'm') ADVANCE(131);
       END_STATE();
     case 156:
-      if (lookahead == 't') ADVANCE(60);
+      if (lookahead == 'm') ADVANCE(213);
       END_STATE();
     case 157:
-      if (lookahead == 't') ADVANCE(125);
+      if (lookahead == 'm') ADVANCE(261);
       END_STATE();
     case 158:
-      if (lookahead == 't') ADVANCE(128);
+      if (lookahead == 'm') ADVANCE(117);
       END_STATE();
     case 159:
-      if (lookahead == 'u') ADVANCE(70);
+      if (lookahead == 'm') ADVANCE(118);
       END_STATE();
     case 160:
-      if (lookahead == 'u') ADVANCE(52);
+      if (lookahead == 'm') ADVANCE(388);
       END_STATE();
     case 161:
-      if (lookahead == 'u') ADVANCE(48);
+      if (lookahead == 'm') ADVANCE(387);
       END_STATE();
     case 162:
-      if (lookahead == 'u') ADVANCE(104);
-      if (lookahead == 'z') ADVANCE(221);
+      if (lookahead == 'n') ADVANCE(85);
       END_STATE();
     case 163:
-      if (lookahead == 'u') ADVANCE(105);
+      if (lookahead == 'n') ADVANCE(425);
       END_STATE();
     case 164:
-      if (lookahead == 'u') ADVANCE(115);
-      if (lookahead == 'z') ADVANCE(228);
+      if (lookahead == 'n') ADVANCE(395);
       END_STATE();
     case 165:
-      if (lookahead == 'u') ADVANCE(107);
+      if (lookahead == 'n') ADVANCE(377);
       END_STATE();
     case 166:
-      if (lookahead == 'u') ADVANCE(117);
-      if (lookahead == 'z') ADVANCE(220);
+      if (lookahead == 'n') ADVANCE(325);
       END_STATE();
     case 167:
-      if (lookahead == 'u') ADVANCE(109);
+      if (lookahead == 'n') ADVANCE(340);
       END_STATE();
     case 168:
-      if (lookahead == 'v') ADVANCE(62);
+      if (lookahead == 'n') ADVANCE(412);
       END_STATE();
     case 169:
-      if (lookahead == 'y') ADVANCE(200);
+      if (lookahead == 'n') ADVANCE(134);
       END_STATE();
     case 170:
-      if (lookahead == '\t' ||
-          lookahead == ' ') SKIP(170);
-      if (lookahead != 0 &&
-          (lookahead < '\t' || '\r' < lookahead) &&
-          lookahead != ':') ADVANCE(217);
+      if (lookahead == 'n') ADVANCE(88);
       END_STATE();
     case 171:
-      if (lookahead == '\t' ||
-          lookahead == ' ') ADVANCE(193);
-      if (lookahead != 0 &&
-          lookahead != '\t' &&
-          lookahead != '\n' &&
-          lookahead != '"') ADVANCE(194);
+      if (lookahead == 'n') ADVANCE(81);
       END_STATE();
     case 172:
-      if (('0' <= lookahead && lookahead <= '9')) ADVANCE(9);
+      if (lookahead == 'n') ADVANCE(92);
       END_STATE();
     case 173:
-      if (('0' <= lookahead && lookahead <= '9')) ADVANCE(15);
+      if (lookahead == 'n') ADVANCE(249);
       END_STATE();
     case 174:
-      if (('0' <= lookahead && lookahead <= '9')) ADVANCE(13);
+      if (lookahead == 'n') ADVANCE(89);
       END_STATE();
     case 175:
-      if (('0' <= lookahead && lookahead <= '9')) ADVANCE(233);
+      if (lookahead == 'n') ADVANCE(113);
       END_STATE();
     case 176:
-      if (('0' <= lookahead && lookahead <= '9')) ADVANCE(12);
+      if (lookahead == 'n') ADVANCE(90);
       END_STATE();
     case 177:
-      if (('0' <= lookahead && lookahead <= '9')) ADVANCE(21);
+      if (lookahead == 'n') ADVANCE(175);
       END_STATE();
     case 178:
-      if (('0' <= lookahead && lookahead <= '9')) ADVANCE(14);
+      if (lookahead == 'n') ADVANCE(93);
       END_STATE();
     case 179:
-      if (('0' <= lookahead && lookahead <= '9')) ADVANCE(237);
+      if (lookahead == 'n') ADVANCE(181);
       END_STATE();
     case 180:
-      if (('0' <= lookahead && lookahead <= '9')) ADVANCE(38);
+      if (lookahead == 'n') ADVANCE(243);
       END_STATE();
     case 181:
-      if (('0' <= lookahead && lookahead <= '9')) ADVANCE(4);
+      if (lookahead == 'n') ADVANCE(99);
       END_STATE();
     case 182:
-      if (('0' <= lookahead && lookahead <= '9')) ADVANCE(207);
+      if (lookahead == 'n') ADVANCE(100);
       END_STATE();
     case 183:
-      if (('0' <= lookahead && lookahead <= '9')) ADVANCE(173);
+      if (lookahead == 'n') ADVANCE(114);
       END_STATE();
     case 184:
-      if (('0' <= lookahead && lookahead <= '9')) ADVANCE(179);
+      if (lookahead == 'n') ADVANCE(388);
       END_STATE();
     case 185:
-      if (('0' <= lookahead && lookahead <= '9')) ADVANCE(181);
+      if (lookahead == 'n') ADVANCE(387);
       END_STATE();
     case 186:
-      if (('0' <= lookahead && lookahead <= '9')) ADVANCE(182);
+      if (lookahead == 'n') ADVANCE(82);
       END_STATE();
     case 187:
-      if (eof) ADVANCE(188);
-      ADVANCE_MAP(
-        '\n', 189,
-        '!', 209,
-        '"', 2,
-        '#', 195,
-        '*', 208,
-        '@', 76,
-        'B', 23,
-        'P', 22,
-        'T', 183,
-        'W', 199,
-        'n', 129,
-        'q', 160,
-      );
-      if (lookahead == '\t' ||
-          lookahead == ' ') SKIP(187);
-      if (('0' <= lookahead && lookahead <= '9')) ADVANCE(7);
+      if (lookahead == 'n') ADVANCE(182);
       END_STATE();
     case 188:
-      ACCEPT_TOKEN(ts_builtin_sym_end);
+      if (lookahead == 'n') ADVANCE(83);
       END_STATE();
     case 189:
-      ACCEPT_TOKEN(anon_sym_LF);
+      if (lookahead == 'n') ADVANCE(183);
       END_STATE();
     case 190:
-      ACCEPT_TOKEN(anon_sym_ATinclude);
+      if (lookahead == 'n') ADVANCE(84);
       END_STATE();
     case 191:
-      ACCEPT_TOKEN(anon_sym_ATplugin);
+      if (lookahead == 'o') ADVANCE(246);
       END_STATE();
     case 192:
-      ACCEPT_TOKEN(anon_sym_DQUOTE);
+      if (lookahead == 'o') ADVANCE(87);
       END_STATE();
     case 193:
-      ACCEPT_TOKEN(aux_sym_file_path_token1);
-      if (lookahead == '\t' ||
-          lookahead == ' ') ADVANCE(193);
-      if (lookahead != 0 &&
-          lookahead != '\t' &&
-          lookahead != '\n' &&
-          lookahead != '"') ADVANCE(194);
+      if (lookahead == 'o') ADVANCE(272);
       END_STATE();
     case 194:
-      ACCEPT_TOKEN(aux_sym_file_path_token1);
-      if (lookahead != 0 &&
-          lookahead != '\n' &&
-          lookahead != '"') ADVANCE(194);
+      if (lookahead == 'o') ADVANCE(276);
       END_STATE();
     case 195:
-      ACCEPT_TOKEN(sym_comment);
-      if (lookahead != 0 &&
-          lookahead != '\n') ADVANCE(195);
+      if (lookahead == 'o') ADVANCE(123);
       END_STATE();
     case 196:
-      ACCEPT_TOKEN(anon_sym_COLON);
+      if (lookahead == 'o') ADVANCE(57);
       END_STATE();
     case 197:
-      ACCEPT_TOKEN(anon_sym_note);
+      if (lookahead == 'o') ADVANCE(126);
       END_STATE();
     case 198:
-      ACCEPT_TOKEN(anon_sym_note);
-      if (lookahead == ':') ADVANCE(206);
+      if (lookahead == 'o') ADVANCE(264);
       END_STATE();
     case 199:
-      ACCEPT_TOKEN(anon_sym_W);
+      if (lookahead == 'o') ADVANCE(237);
       END_STATE();
     case 200:
-      ACCEPT_TOKEN(anon_sym_query);
+      if (lookahead == 'o') ADVANCE(175);
       END_STATE();
     case 201:
-      ACCEPT_TOKEN(anon_sym_ATsession);
+      if (lookahead == 'o') ADVANCE(181);
       END_STATE();
     case 202:
-      ACCEPT_TOKEN(anon_sym_ATend);
+      if (lookahead == 'o') ADVANCE(167);
       END_STATE();
     case 203:
-      ACCEPT_TOKEN(anon_sym_ATend);
-      if (lookahead != 0 &&
-          (lookahead < '\t' || '\r' < lookahead) &&
-          lookahead != ' ' &&
-          lookahead != ':') ADVANCE(217);
+      if (lookahead == 'o') ADVANCE(199);
+      if (lookahead == 't') ADVANCE(424);
       END_STATE();
     case 204:
-      ACCEPT_TOKEN(anon_sym_ATmovement);
+      if (lookahead == 'o') ADVANCE(182);
       END_STATE();
     case 205:
-      ACCEPT_TOKEN(anon_sym_ATtemplate);
+      if (lookahead == 'o') ADVANCE(183);
       END_STATE();
     case 206:
-      ACCEPT_TOKEN(anon_sym_note_COLON);
+      if (lookahead == 'o') ADVANCE(251);
       END_STATE();
     case 207:
-      ACCEPT_TOKEN(sym_date);
+      if (lookahead == 'o') ADVANCE(127);
       END_STATE();
     case 208:
-      ACCEPT_TOKEN(anon_sym_STAR);
+      if (lookahead == 'o') ADVANCE(267);
       END_STATE();
     case 209:
-      ACCEPT_TOKEN(anon_sym_BANG);
+      if (lookahead == 'o') ADVANCE(128);
       END_STATE();
     case 210:
-      ACCEPT_TOKEN(aux_sym_item_token1);
-      if (lookahead == ':') ADVANCE(206);
-      if (lookahead != 0 &&
-          (lookahead < '\t' || '\r' < lookahead) &&
-          lookahead != ' ') ADVANCE(217);
+      if (lookahead == 'o') ADVANCE(269);
       END_STATE();
     case 211:
-      ACCEPT_TOKEN(aux_sym_item_token1);
-      if (lookahead == 'd') ADVANCE(203);
-      if (lookahead != 0 &&
-          (lookahead < '\t' || '\r' < lookahead) &&
-          lookahead != ' ' &&
-          lookahead != ':') ADVANCE(217);
+      if (lookahead == 'o') ADVANCE(271);
       END_STATE();
     case 212:
-      ACCEPT_TOKEN(aux_sym_item_token1);
-      if (lookahead == 'e') ADVANCE(214);
-      if (lookahead != 0 &&
-          (lookahead < '\t' || '\r' < lookahead) &&
-          lookahead != ' ' &&
-          lookahead != ':') ADVANCE(217);
+      if (lookahead == 'p') ADVANCE(418);
       END_STATE();
     case 213:
-      ACCEPT_TOKEN(aux_sym_item_token1);
-      if (lookahead == 'e') ADVANCE(210);
-      if (lookahead != 0 &&
-          (lookahead < '\t' || '\r' < lookahead) &&
-          lookahead != ' ' &&
-          lookahead != ':') ADVANCE(217);
+      if (lookahead == 'p') ADVANCE(145);
       END_STATE();
     case 214:
-      ACCEPT_TOKEN(aux_sym_item_token1);
-      if (lookahead == 'n') ADVANCE(211);
-      if (lookahead != 0 &&
-          (lookahead < '\t' || '\r' < lookahead) &&
-          lookahead != ' ' &&
-          lookahead != ':') ADVANCE(217);
+      if (lookahead == 'p') ADVANCE(194);
       END_STATE();
     case 215:
-      ACCEPT_TOKEN(aux_sym_item_token1);
-      if (lookahead == 'o') ADVANCE(216);
-      if (lookahead != 0 &&
-          (lookahead < '\t' || '\r' < lookahead) &&
-          lookahead != ' ' &&
-          lookahead != ':') ADVANCE(217);
+      if (lookahead == 'p') ADVANCE(108);
       END_STATE();
     case 216:
-      ACCEPT_TOKEN(aux_sym_item_token1);
-      if (lookahead == 't') ADVANCE(213);
-      if (lookahead != 0 &&
-          (lookahead < '\t' || '\r' < lookahead) &&
-          lookahead != ' ' &&
-          lookahead != ':') ADVANCE(217);
+      if (lookahead == 'r') ADVANCE(424);
       END_STATE();
     case 217:
-      ACCEPT_TOKEN(aux_sym_item_token1);
-      if (lookahead != 0 &&
-          (lookahead < '\t' || '\r' < lookahead) &&
-          lookahead != ' ' &&
-          lookahead != ':') ADVANCE(217);
+      if (lookahead == 'r') ADVANCE(80);
       END_STATE();
     case 218:
-      ACCEPT_TOKEN(aux_sym_name_token1);
-      if (lookahead == '\t' ||
-          lookahead == ' ') ADVANCE(218);
-      if (lookahead != 0 &&
-          lookahead != '\t' &&
-          lookahead != '\n') ADVANCE(219);
+      if (lookahead == 'r') ADVANCE(275);
       END_STATE();
     case 219:
-      ACCEPT_TOKEN(aux_sym_name_token1);
-      if (lookahead != 0 &&
-          lookahead != '\n') ADVANCE(219);
+      if (lookahead == 'r') ADVANCE(63);
       END_STATE();
     case 220:
-      ACCEPT_TOKEN(sym_weight);
-      if (lookahead == '+') ADVANCE(176);
+      if (lookahead == 'r') ADVANCE(157);
       END_STATE();
     case 221:
-      ACCEPT_TOKEN(sym_weight);
-      if (lookahead == '+') ADVANCE(176);
-      if (lookahead == '/') ADVANCE(19);
+      if (lookahead == 'r') ADVANCE(86);
       END_STATE();
     case 222:
-      ACCEPT_TOKEN(sym_weight);
-      if (lookahead == '+') ADVANCE(176);
-      if (lookahead == '/') ADVANCE(19);
-      if (lookahead == 'a') ADVANCE(77);
+      if (lookahead == 'r') ADVANCE(59);
       END_STATE();
     case 223:
-      ACCEPT_TOKEN(sym_weight);
-      if (lookahead == '+') ADVANCE(176);
-      if (lookahead == '/') ADVANCE(19);
-      if (lookahead == 'o') ADVANCE(110);
+      if (lookahead == 'r') ADVANCE(235);
       END_STATE();
     case 224:
-      ACCEPT_TOKEN(sym_weight);
-      if (lookahead == '+') ADVANCE(176);
-      if (lookahead == '/') ADVANCE(19);
-      if (lookahead == 'r') ADVANCE(222);
+      if (lookahead == 'r') ADVANCE(65);
       END_STATE();
     case 225:
-      ACCEPT_TOKEN(sym_weight);
-      if (lookahead == '+') ADVANCE(176);
-      if (lookahead == 'a') ADVANCE(80);
+      if (lookahead == 'r') ADVANCE(61);
       END_STATE();
     case 226:
-      ACCEPT_TOKEN(sym_weight);
-      if (lookahead == '+') ADVANCE(176);
-      if (lookahead == 'o') ADVANCE(116);
+      if (lookahead == 'r') ADVANCE(66);
       END_STATE();
     case 227:
-      ACCEPT_TOKEN(sym_weight);
-      if (lookahead == '+') ADVANCE(176);
-      if (lookahead == 'r') ADVANCE(225);
+      if (lookahead == 'r') ADVANCE(62);
       END_STATE();
     case 228:
-      ACCEPT_TOKEN(sym_weight);
-      if (lookahead == '/') ADVANCE(19);
+      if (lookahead == 'r') ADVANCE(67);
       END_STATE();
     case 229:
-      ACCEPT_TOKEN(sym_weight);
-      if (lookahead == '/') ADVANCE(19);
-      if (lookahead == 'a') ADVANCE(79);
+      if (lookahead == 'r') ADVANCE(64);
       END_STATE();
     case 230:
-      ACCEPT_TOKEN(sym_weight);
-      if (lookahead == '/') ADVANCE(19);
-      if (lookahead == 'o') ADVANCE(112);
+      if (lookahead == 's') ADVANCE(326);
       END_STATE();
     case 231:
-      ACCEPT_TOKEN(sym_weight);
-      if (lookahead == '/') ADVANCE(19);
-      if (lookahead == 'r') ADVANCE(229);
+      if (lookahead == 's') ADVANCE(412);
+      if (('0' <= lookahead && lookahead <= '9')) ADVANCE(231);
       END_STATE();
     case 232:
+      if (lookahead == 's') ADVANCE(238);
+      END_STATE();
+    case 233:
+      if (lookahead == 's') ADVANCE(234);
+      END_STATE();
+    case 234:
+      if (lookahead == 's') ADVANCE(142);
+      END_STATE();
+    case 235:
+      if (lookahead == 's') ADVANCE(112);
+      END_STATE();
+    case 236:
+      if (lookahead == 's') ADVANCE(254);
+      END_STATE();
+    case 237:
+      if (lookahead == 't') ADVANCE(424);
+      END_STATE();
+    case 238:
+      if (lookahead == 't') ADVANCE(338);
+      END_STATE();
+    case 239:
+      if (lookahead == 't') ADVANCE(395);
+      END_STATE();
+    case 240:
+      if (lookahead == 't') ADVANCE(111);
+      END_STATE();
+    case 241:
+      if (lookahead == 't') ADVANCE(377);
+      END_STATE();
+    case 242:
+      if (lookahead == 't') ADVANCE(345);
+      END_STATE();
+    case 243:
+      if (lookahead == 't') ADVANCE(347);
+      END_STATE();
+    case 244:
+      if (lookahead == 't') ADVANCE(343);
+      END_STATE();
+    case 245:
+      if (lookahead == 't') ADVANCE(327);
+      END_STATE();
+    case 246:
+      if (lookahead == 't') ADVANCE(98);
+      END_STATE();
+    case 247:
+      if (lookahead == 't') ADVANCE(230);
+      END_STATE();
+    case 248:
+      if (lookahead == 't') ADVANCE(200);
+      END_STATE();
+    case 249:
+      if (lookahead == 't') ADVANCE(141);
+      END_STATE();
+    case 250:
+      if (lookahead == 't') ADVANCE(102);
+      END_STATE();
+    case 251:
+      if (lookahead == 't') ADVANCE(103);
+      END_STATE();
+    case 252:
+      if (lookahead == 't') ADVANCE(388);
+      END_STATE();
+    case 253:
+      if (lookahead == 't') ADVANCE(387);
+      END_STATE();
+    case 254:
+      if (lookahead == 't') ADVANCE(280);
+      END_STATE();
+    case 255:
+      if (lookahead == 't') ADVANCE(201);
+      END_STATE();
+    case 256:
+      if (lookahead == 't') ADVANCE(110);
+      END_STATE();
+    case 257:
+      if (lookahead == 't') ADVANCE(204);
+      END_STATE();
+    case 258:
+      if (lookahead == 't') ADVANCE(205);
+      END_STATE();
+    case 259:
+      if (lookahead == 'u') ADVANCE(122);
+      END_STATE();
+    case 260:
+      if (lookahead == 'u') ADVANCE(106);
+      END_STATE();
+    case 261:
+      if (lookahead == 'u') ADVANCE(212);
+      END_STATE();
+    case 262:
+      if (lookahead == 'u') ADVANCE(91);
+      END_STATE();
+    case 263:
+      if (lookahead == 'u') ADVANCE(171);
+      if (lookahead == 'z') ADVANCE(388);
+      END_STATE();
+    case 264:
+      if (lookahead == 'u') ADVANCE(172);
+      END_STATE();
+    case 265:
+      if (lookahead == 'u') ADVANCE(136);
+      END_STATE();
+    case 266:
+      if (lookahead == 'u') ADVANCE(186);
+      if (lookahead == 'z') ADVANCE(395);
+      END_STATE();
+    case 267:
+      if (lookahead == 'u') ADVANCE(174);
+      END_STATE();
+    case 268:
+      if (lookahead == 'u') ADVANCE(188);
+      if (lookahead == 'z') ADVANCE(377);
+      END_STATE();
+    case 269:
+      if (lookahead == 'u') ADVANCE(176);
+      END_STATE();
+    case 270:
+      if (lookahead == 'u') ADVANCE(190);
+      if (lookahead == 'z') ADVANCE(387);
+      END_STATE();
+    case 271:
+      if (lookahead == 'u') ADVANCE(178);
+      END_STATE();
+    case 272:
+      if (lookahead == 'v') ADVANCE(107);
+      END_STATE();
+    case 273:
+      if (lookahead == 'w') ADVANCE(109);
+      END_STATE();
+    case 274:
+      if (lookahead == 'y') ADVANCE(273);
+      END_STATE();
+    case 275:
+      if (lookahead == 'y') ADVANCE(339);
+      END_STATE();
+    case 276:
+      if (lookahead == '\t' ||
+          lookahead == ' ') ADVANCE(277);
+      END_STATE();
+    case 277:
+      if (lookahead == '\t' ||
+          lookahead == ' ') ADVANCE(277);
+      if (('0' <= lookahead && lookahead <= '9')) ADVANCE(16);
+      END_STATE();
+    case 278:
+      if (lookahead == '\t' ||
+          lookahead == ' ') SKIP(278);
+      if (lookahead != 0 &&
+          (lookahead < '\t' || '\r' < lookahead) &&
+          lookahead != ':') ADVANCE(374);
+      END_STATE();
+    case 279:
+      if (lookahead == '\t' ||
+          lookahead == ' ') ADVANCE(330);
+      if (lookahead != 0 &&
+          lookahead != '\t' &&
+          lookahead != '\n' &&
+          lookahead != '"') ADVANCE(331);
+      END_STATE();
+    case 280:
+      if (lookahead == '\t' ||
+          lookahead == ' ') ADVANCE(281);
+      END_STATE();
+    case 281:
+      if (lookahead == '\t' ||
+          lookahead == ' ') ADVANCE(281);
+      if (('0' <= lookahead && lookahead <= '9')) ADVANCE(25);
+      END_STATE();
+    case 282:
+      if (lookahead == 'I' ||
+          lookahead == 'i') ADVANCE(284);
+      END_STATE();
+    case 283:
+      if (lookahead == 'R' ||
+          lookahead == 'r') ADVANCE(282);
+      if (('0' <= lookahead && lookahead <= '9')) ADVANCE(410);
+      END_STATE();
+    case 284:
+      if (lookahead == 'R' ||
+          lookahead == 'r') ADVANCE(290);
+      END_STATE();
+    case 285:
+      if (lookahead == 'W' ||
+          lookahead == 'w') ADVANCE(384);
+      END_STATE();
+    case 286:
+      if (('0' <= lookahead && lookahead <= '9')) ADVANCE(416);
+      END_STATE();
+    case 287:
+      if (('0' <= lookahead && lookahead <= '9')) ADVANCE(20);
+      END_STATE();
+    case 288:
+      if (('0' <= lookahead && lookahead <= '9')) ADVANCE(29);
+      END_STATE();
+    case 289:
+      if (('0' <= lookahead && lookahead <= '9')) ADVANCE(383);
+      END_STATE();
+    case 290:
+      if (('0' <= lookahead && lookahead <= '9')) ADVANCE(411);
+      END_STATE();
+    case 291:
+      if (('0' <= lookahead && lookahead <= '9')) ADVANCE(23);
+      END_STATE();
+    case 292:
+      if (('0' <= lookahead && lookahead <= '9')) ADVANCE(417);
+      END_STATE();
+    case 293:
+      if (('0' <= lookahead && lookahead <= '9')) ADVANCE(15);
+      END_STATE();
+    case 294:
+      if (('0' <= lookahead && lookahead <= '9')) ADVANCE(49);
+      END_STATE();
+    case 295:
+      if (('0' <= lookahead && lookahead <= '9')) ADVANCE(27);
+      END_STATE();
+    case 296:
+      if (('0' <= lookahead && lookahead <= '9')) ADVANCE(78);
+      END_STATE();
+    case 297:
+      if (('0' <= lookahead && lookahead <= '9')) ADVANCE(423);
+      END_STATE();
+    case 298:
+      if (('0' <= lookahead && lookahead <= '9')) ADVANCE(11);
+      END_STATE();
+    case 299:
+      if (('0' <= lookahead && lookahead <= '9')) ADVANCE(12);
+      END_STATE();
+    case 300:
+      if (('0' <= lookahead && lookahead <= '9')) ADVANCE(415);
+      END_STATE();
+    case 301:
+      if (('0' <= lookahead && lookahead <= '9')) ADVANCE(6);
+      END_STATE();
+    case 302:
+      if (('0' <= lookahead && lookahead <= '9')) ADVANCE(19);
+      END_STATE();
+    case 303:
+      if (('0' <= lookahead && lookahead <= '9')) ADVANCE(153);
+      END_STATE();
+    case 304:
+      if (('0' <= lookahead && lookahead <= '9')) ADVANCE(350);
+      END_STATE();
+    case 305:
+      if (('0' <= lookahead && lookahead <= '9')) ADVANCE(231);
+      END_STATE();
+    case 306:
+      if (('0' <= lookahead && lookahead <= '9')) ADVANCE(24);
+      END_STATE();
+    case 307:
+      if (('0' <= lookahead && lookahead <= '9')) ADVANCE(79);
+      END_STATE();
+    case 308:
+      if (('0' <= lookahead && lookahead <= '9')) ADVANCE(13);
+      END_STATE();
+    case 309:
+      if (('0' <= lookahead && lookahead <= '9')) ADVANCE(14);
+      END_STATE();
+    case 310:
+      if (('0' <= lookahead && lookahead <= '9')) ADVANCE(288);
+      END_STATE();
+    case 311:
+      if (('0' <= lookahead && lookahead <= '9')) ADVANCE(288);
+      if (('a' <= lookahead && lookahead <= 'z')) ADVANCE(319);
+      END_STATE();
+    case 312:
+      if (('0' <= lookahead && lookahead <= '9')) ADVANCE(297);
+      END_STATE();
+    case 313:
+      if (('0' <= lookahead && lookahead <= '9')) ADVANCE(302);
+      END_STATE();
+    case 314:
+      if (('0' <= lookahead && lookahead <= '9')) ADVANCE(304);
+      END_STATE();
+    case 315:
+      if (('0' <= lookahead && lookahead <= '9')) ADVANCE(17);
+      END_STATE();
+    case 316:
+      if (('0' <= lookahead && lookahead <= '9')) ADVANCE(314);
+      END_STATE();
+    case 317:
+      if (('0' <= lookahead && lookahead <= '9')) ADVANCE(18);
+      END_STATE();
+    case 318:
+      if (('0' <= lookahead && lookahead <= '9')) ADVANCE(316);
+      END_STATE();
+    case 319:
+      if (('a' <= lookahead && lookahead <= 'z')) ADVANCE(4);
+      END_STATE();
+    case 320:
+      if (('a' <= lookahead && lookahead <= 'z')) ADVANCE(319);
+      END_STATE();
+    case 321:
+      if (eof) ADVANCE(322);
+      ADVANCE_MAP(
+        '\n', 323,
+        '"', 8,
+        '#', 333,
+        ':', 334,
+        '@', 75,
+        'A', 39,
+        'B', 53,
+        'P', 51,
+        'T', 311,
+        '[', 55,
+        'b', 285,
+        'r', 115,
+        't', 95,
+        'v', 286,
+        'w', 56,
+      );
+      if (lookahead == '\t' ||
+          lookahead == ' ') SKIP(321);
+      if (('0' <= lookahead && lookahead <= '9')) ADVANCE(382);
+      if (('C' <= lookahead && lookahead <= 'Z')) ADVANCE(320);
+      END_STATE();
+    case 322:
+      ACCEPT_TOKEN(ts_builtin_sym_end);
+      END_STATE();
+    case 323:
+      ACCEPT_TOKEN(anon_sym_LF);
+      END_STATE();
+    case 324:
+      ACCEPT_TOKEN(anon_sym_ATinclude);
+      END_STATE();
+    case 325:
+      ACCEPT_TOKEN(anon_sym_ATplugin);
+      END_STATE();
+    case 326:
+      ACCEPT_TOKEN(anon_sym_ATunits);
+      END_STATE();
+    case 327:
+      ACCEPT_TOKEN(anon_sym_ATbodyweight);
+      END_STATE();
+    case 328:
+      ACCEPT_TOKEN(anon_sym_ATgoal);
+      END_STATE();
+    case 329:
+      ACCEPT_TOKEN(anon_sym_DQUOTE);
+      END_STATE();
+    case 330:
+      ACCEPT_TOKEN(aux_sym_file_path_token1);
+      if (lookahead == '\t' ||
+          lookahead == ' ') ADVANCE(330);
+      if (lookahead != 0 &&
+          lookahead != '\t' &&
+          lookahead != '\n' &&
+          lookahead != '"') ADVANCE(331);
+      END_STATE();
+    case 331:
+      ACCEPT_TOKEN(aux_sym_file_path_token1);
+      if (lookahead != 0 &&
+          lookahead != '\n' &&
+          lookahead != '"') ADVANCE(331);
+      END_STATE();
+    case 332:
+      ACCEPT_TOKEN(sym_comment);
+      if (lookahead == '\t' ||
+          (0x0b <= lookahead && lookahead <= '\r') ||
+          lookahead == ' ' ||
+          lookahead == ':') ADVANCE(333);
+      if (lookahead != 0 &&
+          (lookahead < '\t' || '\r' < lookahead)) ADVANCE(332);
+      END_STATE();
+    case 333:
+      ACCEPT_TOKEN(sym_comment);
+      if (lookahead != 0 &&
+          lookahead != '\n') ADVANCE(333);
+      END_STATE();
+    case 334:
+      ACCEPT_TOKEN(anon_sym_COLON);
+      END_STATE();
+    case 335:
+      ACCEPT_TOKEN(anon_sym_note);
+      END_STATE();
+    case 336:
+      ACCEPT_TOKEN(anon_sym_note);
+      if (lookahead == ':') ADVANCE(349);
+      END_STATE();
+    case 337:
+      ACCEPT_TOKEN(anon_sym_W);
+      END_STATE();
+    case 338:
+      ACCEPT_TOKEN(anon_sym_rest);
+      END_STATE();
+    case 339:
+      ACCEPT_TOKEN(anon_sym_query);
+      END_STATE();
+    case 340:
+      ACCEPT_TOKEN(anon_sym_ATsession);
+      END_STATE();
+    case 341:
+      ACCEPT_TOKEN(anon_sym_ATend);
+      END_STATE();
+    case 342:
+      ACCEPT_TOKEN(anon_sym_ATend);
+      if (lookahead != 0 &&
+          (lookahead < '\t' || '\r' < lookahead) &&
+          lookahead != ' ' &&
+          lookahead != ':') ADVANCE(374);
+      END_STATE();
+    case 343:
+      ACCEPT_TOKEN(anon_sym_ATsuperset);
+      END_STATE();
+    case 344:
+      ACCEPT_TOKEN(anon_sym_ATsuperset);
+      if (lookahead != 0 &&
+          (lookahead < '\t' || '\r' < lookahead) &&
+          lookahead != ' ' &&
+          lookahead != ':') ADVANCE(374);
+      END_STATE();
+    case 345:
+      ACCEPT_TOKEN(anon_sym_ATcircuit);
+      END_STATE();
+    case 346:
+      ACCEPT_TOKEN(anon_sym_ATcircuit);
+      if (lookahead != 0 &&
+          (lookahead < '\t' || '\r' < lookahead) &&
+          lookahead != ' ' &&
+          lookahead != ':') ADVANCE(374);
+      END_STATE();
+    case 347:
+      ACCEPT_TOKEN(anon_sym_ATmovement);
+      END_STATE();
+    case 348:
+      ACCEPT_TOKEN(anon_sym_ATtemplate);
+      END_STATE();
+    case 349:
+      ACCEPT_TOKEN(anon_sym_note_COLON);
+      END_STATE();
+    case 350:
+      ACCEPT_TOKEN(sym_date);
+      END_STATE();
+    case 351:
+      ACCEPT_TOKEN(anon_sym_STAR);
+      END_STATE();
+    case 352:
+      ACCEPT_TOKEN(anon_sym_BANG);
+      END_STATE();
+    case 353:
+      ACCEPT_TOKEN(aux_sym_item_token1);
+      if (lookahead == ':') ADVANCE(349);
+      if (lookahead != 0 &&
+          (lookahead < '\t' || '\r' < lookahead) &&
+          lookahead != ' ') ADVANCE(374);
+      END_STATE();
+    case 354:
+      ACCEPT_TOKEN(aux_sym_item_token1);
+      if (lookahead == 'c') ADVANCE(361);
+      if (lookahead == 'e') ADVANCE(363);
+      if (lookahead == 's') ADVANCE(372);
+      if (lookahead != 0 &&
+          (lookahead < '\t' || '\r' < lookahead) &&
+          lookahead != ' ' &&
+          lookahead != ':') ADVANCE(374);
+      END_STATE();
+    case 355:
+      ACCEPT_TOKEN(aux_sym_item_token1);
+      if (lookahead == 'c') ADVANCE(373);
+      if (lookahead != 0 &&
+          (lookahead < '\t' || '\r' < lookahead) &&
+          lookahead != ' ' &&
+          lookahead != ':') ADVANCE(374);
+      END_STATE();
+    case 356:
+      ACCEPT_TOKEN(aux_sym_item_token1);
+      if (lookahead == 'd') ADVANCE(342);
+      if (lookahead != 0 &&
+          (lookahead < '\t' || '\r' < lookahead) &&
+          lookahead != ' ' &&
+          lookahead != ':') ADVANCE(374);
+      END_STATE();
+    case 357:
+      ACCEPT_TOKEN(aux_sym_item_token1);
+      if (lookahead == 'e') ADVANCE(363);
+      if (lookahead != 0 &&
+          (lookahead < '\t' || '\r' < lookahead) &&
+          lookahead != ' ' &&
+          lookahead != ':') ADVANCE(374);
+      END_STATE();
+    case 358:
+      ACCEPT_TOKEN(aux_sym_item_token1);
+      if (lookahead == 'e') ADVANCE(353);
+      if (lookahead != 0 &&
+          (lookahead < '\t' || '\r' < lookahead) &&
+          lookahead != ' ' &&
+          lookahead != ':') ADVANCE(374);
+      END_STATE();
+    case 359:
+      ACCEPT_TOKEN(aux_sym_item_token1);
+      if (lookahead == 'e') ADVANCE(367);
+      if (lookahead != 0 &&
+          (lookahead < '\t' || '\r' < lookahead) &&
+          lookahead != ' ' &&
+          lookahead != ':') ADVANCE(374);
+      END_STATE();
+    case 360:
+      ACCEPT_TOKEN(aux_sym_item_token1);
+      if (lookahead == 'e') ADVANCE(371);
+      if (lookahead != 0 &&
+          (lookahead < '\t' || '\r' < lookahead) &&
+          lookahead != ' ' &&
+          lookahead != ':') ADVANCE(374);
+      END_STATE();
+    case 361:
+      ACCEPT_TOKEN(aux_sym_item_token1);
+      if (lookahead == 'i') ADVANCE(366);
+      if (lookahead != 0 &&
+          (lookahead < '\t' || '\r' < lookahead) &&
+          lookahead != ' ' &&
+          lookahead != ':') ADVANCE(374);
+      END_STATE();
+    case 362:
+      ACCEPT_TOKEN(aux_sym_item_token1);
+      if (lookahead == 'i') ADVANCE(370);
+      if (lookahead != 0 &&
+          (lookahead < '\t' || '\r' < lookahead) &&
+          lookahead != ' ' &&
+          lookahead != ':') ADVANCE(374);
+      END_STATE();
+    case 363:
+      ACCEPT_TOKEN(aux_sym_item_token1);
+      if (lookahead == 'n') ADVANCE(356);
+      if (lookahead != 0 &&
+          (lookahead < '\t' || '\r' < lookahead) &&
+          lookahead != ' ' &&
+          lookahead != ':') ADVANCE(374);
+      END_STATE();
+    case 364:
+      ACCEPT_TOKEN(aux_sym_item_token1);
+      if (lookahead == 'o') ADVANCE(369);
+      if (lookahead != 0 &&
+          (lookahead < '\t' || '\r' < lookahead) &&
+          lookahead != ' ' &&
+          lookahead != ':') ADVANCE(374);
+      END_STATE();
+    case 365:
+      ACCEPT_TOKEN(aux_sym_item_token1);
+      if (lookahead == 'p') ADVANCE(359);
+      if (lookahead != 0 &&
+          (lookahead < '\t' || '\r' < lookahead) &&
+          lookahead != ' ' &&
+          lookahead != ':') ADVANCE(374);
+      END_STATE();
+    case 366:
+      ACCEPT_TOKEN(aux_sym_item_token1);
+      if (lookahead == 'r') ADVANCE(355);
+      if (lookahead != 0 &&
+          (lookahead < '\t' || '\r' < lookahead) &&
+          lookahead != ' ' &&
+          lookahead != ':') ADVANCE(374);
+      END_STATE();
+    case 367:
+      ACCEPT_TOKEN(aux_sym_item_token1);
+      if (lookahead == 'r') ADVANCE(368);
+      if (lookahead != 0 &&
+          (lookahead < '\t' || '\r' < lookahead) &&
+          lookahead != ' ' &&
+          lookahead != ':') ADVANCE(374);
+      END_STATE();
+    case 368:
+      ACCEPT_TOKEN(aux_sym_item_token1);
+      if (lookahead == 's') ADVANCE(360);
+      if (lookahead != 0 &&
+          (lookahead < '\t' || '\r' < lookahead) &&
+          lookahead != ' ' &&
+          lookahead != ':') ADVANCE(374);
+      END_STATE();
+    case 369:
+      ACCEPT_TOKEN(aux_sym_item_token1);
+      if (lookahead == 't') ADVANCE(358);
+      if (lookahead != 0 &&
+          (lookahead < '\t' || '\r' < lookahead) &&
+          lookahead != ' ' &&
+          lookahead != ':') ADVANCE(374);
+      END_STATE();
+    case 370:
+      ACCEPT_TOKEN(aux_sym_item_token1);
+      if (lookahead == 't') ADVANCE(346);
+      if (lookahead != 0 &&
+          (lookahead < '\t' || '\r' < lookahead) &&
+          lookahead != ' ' &&
+          lookahead != ':') ADVANCE(374);
+      END_STATE();
+    case 371:
+      ACCEPT_TOKEN(aux_sym_item_token1);
+      if (lookahead == 't') ADVANCE(344);
+      if (lookahead != 0 &&
+          (lookahead < '\t' || '\r' < lookahead) &&
+          lookahead != ' ' &&
+          lookahead != ':') ADVANCE(374);
+      END_STATE();
+    case 372:
+      ACCEPT_TOKEN(aux_sym_item_token1);
+      if (lookahead == 'u') ADVANCE(365);
+      if (lookahead != 0 &&
+          (lookahead < '\t' || '\r' < lookahead) &&
+          lookahead != ' ' &&
+          lookahead != ':') ADVANCE(374);
+      END_STATE();
+    case 373:
+      ACCEPT_TOKEN(aux_sym_item_token1);
+      if (lookahead == 'u') ADVANCE(362);
+      if (lookahead != 0 &&
+          (lookahead < '\t' || '\r' < lookahead) &&
+          lookahead != ' ' &&
+          lookahead != ':') ADVANCE(374);
+      END_STATE();
+    case 374:
+      ACCEPT_TOKEN(aux_sym_item_token1);
+      if (lookahead != 0 &&
+          (lookahead < '\t' || '\r' < lookahead) &&
+          lookahead != ' ' &&
+          lookahead != ':') ADVANCE(374);
+      END_STATE();
+    case 375:
+      ACCEPT_TOKEN(aux_sym_name_token1);
+      if (lookahead == '\t' ||
+          lookahead == ' ') ADVANCE(375);
+      if (lookahead != 0 &&
+          lookahead != '\t' &&
+          lookahead != '\n') ADVANCE(376);
+      END_STATE();
+    case 376:
+      ACCEPT_TOKEN(aux_sym_name_token1);
+      if (lookahead != 0 &&
+          lookahead != '\n') ADVANCE(376);
+      END_STATE();
+    case 377:
+      ACCEPT_TOKEN(sym_weight);
+      END_STATE();
+    case 378:
+      ACCEPT_TOKEN(sym_weight);
+      ADVANCE_MAP(
+        '%', 402,
+        '-', 313,
+        '.', 289,
+        '/', 35,
+        'c', 58,
+        'f', 203,
+        'g', 391,
+        'i', 163,
+        'k', 124,
+        'l', 76,
+        'm', 426,
+        'n', 155,
+        'o', 263,
+        'p', 198,
+        's', 248,
+        't', 390,
+        'x', 9,
+        'y', 60,
+      );
+      if (('0' <= lookahead && lookahead <= '9')) ADVANCE(379);
+      END_STATE();
+    case 379:
+      ACCEPT_TOKEN(sym_weight);
+      ADVANCE_MAP(
+        '%', 402,
+        '.', 289,
+        '/', 35,
+        'c', 58,
+        'f', 203,
+        'g', 391,
+        'i', 163,
+        'k', 124,
+        'l', 76,
+        'm', 426,
+        'n', 155,
+        'o', 263,
+        'p', 198,
+        's', 248,
+        't', 390,
+        'x', 9,
+        'y', 60,
+      );
+      if (('0' <= lookahead && lookahead <= '9')) ADVANCE(379);
+      END_STATE();
+    case 380:
+      ACCEPT_TOKEN(sym_weight);
+      ADVANCE_MAP(
+        '%', 402,
+        '.', 289,
+        '/', 35,
+        'c', 58,
+        'f', 203,
+        'g', 391,
+        'i', 163,
+        'k', 124,
+        'l', 76,
+        'm', 426,
+        'n', 155,
+        'o', 263,
+        'p', 198,
+        's', 248,
+        't', 390,
+        'x', 9,
+        'y', 60,
+      );
+      if (('0' <= lookahead && lookahead <= '9')) ADVANCE(378);
+      END_STATE();
+    case 381:
+      ACCEPT_TOKEN(sym_weight);
+      ADVANCE_MAP(
+        '%', 402,
+        '.', 289,
+        '/', 35,
+        'c', 58,
+        'f', 203,
+        'g', 391,
+        'i', 163,
+        'k', 124,
+        'l', 76,
+        'm', 426,
+        'n', 155,
+        'o', 263,
+        'p', 198,
+        's', 248,
+        't', 390,
+        'x', 9,
+        'y', 60,
+      );
+      if (('0' <= lookahead && lookahead <= '9')) ADVANCE(380);
+      END_STATE();
+    case 382:
+      ACCEPT_TOKEN(sym_weight);
+      ADVANCE_MAP(
+        '%', 402,
+        '.', 289,
+        '/', 35,
+        'c', 58,
+        'f', 203,
+        'g', 391,
+        'i', 163,
+        'k', 124,
+        'l', 76,
+        'm', 426,
+        'n', 155,
+        'o', 263,
+        'p', 198,
+        's', 248,
+        't', 390,
+        'x', 9,
+        'y', 60,
+      );
+      if (('0' <= lookahead && lookahead <= '9')) ADVANCE(381);
+      END_STATE();
+    case 383:
+      ACCEPT_TOKEN(sym_weight);
+      ADVANCE_MAP(
+        '%', 402,
+        '/', 37,
+        'c', 58,
+        'f', 203,
+        'g', 391,
+        'i', 163,
+        'k', 124,
+        'l', 76,
+        'm', 426,
+        'n', 155,
+        'o', 263,
+        'p', 198,
+        's', 248,
+        't', 390,
+        'y', 60,
+      );
+      if (('0' <= lookahead && lookahead <= '9')) ADVANCE(383);
+      END_STATE();
+    case 384:
+      ACCEPT_TOKEN(sym_weight);
+      if (lookahead == '+') ADVANCE(291);
+      END_STATE();
+    case 385:
+      ACCEPT_TOKEN(sym_weight);
+      if (lookahead == '+') ADVANCE(291);
+      if (lookahead == '/') ADVANCE(37);
+      END_STATE();
+    case 386:
+      ACCEPT_TOKEN(sym_weight);
+      if (lookahead == '+') ADVANCE(291);
+      if (('a' <= lookahead && lookahead <= 'z')) ADVANCE(4);
+      END_STATE();
+    case 387:
+      ACCEPT_TOKEN(sym_weight);
+      if (lookahead == '+') ADVANCE(306);
+      END_STATE();
+    case 388:
+      ACCEPT_TOKEN(sym_weight);
+      if (lookahead == '+') ADVANCE(306);
+      if (lookahead == '/') ADVANCE(37);
+      END_STATE();
+    case 389:
+      ACCEPT_TOKEN(sym_weight);
+      if (lookahead == '+') ADVANCE(306);
+      if (lookahead == '/') ADVANCE(37);
+      if (lookahead == 'a') ADVANCE(135);
+      END_STATE();
+    case 390:
+      ACCEPT_TOKEN(sym_weight);
+      if (lookahead == '+') ADVANCE(306);
+      if (lookahead == '/') ADVANCE(37);
+      if (lookahead == 'o') ADVANCE(177);
+      END_STATE();
+    case 391:
+      ACCEPT_TOKEN(sym_weight);
+      if (lookahead == '+') ADVANCE(306);
+      if (lookahead == '/') ADVANCE(37);
+      if (lookahead == 'r') ADVANCE(389);
+      END_STATE();
+    case 392:
+      ACCEPT_TOKEN(sym_weight);
+      if (lookahead == '+') ADVANCE(306);
+      if (lookahead == 'a') ADVANCE(140);
+      END_STATE();
+    case 393:
+      ACCEPT_TOKEN(sym_weight);
+      if (lookahead == '+') ADVANCE(306);
+      if (lookahead == 'o') ADVANCE(189);
+      END_STATE();
+    case 394:
+      ACCEPT_TOKEN(sym_weight);
+      if (lookahead == '+') ADVANCE(306);
+      if (lookahead == 'r') ADVANCE(392);
+      END_STATE();
+    case 395:
+      ACCEPT_TOKEN(sym_weight);
+      if (lookahead == '/') ADVANCE(37);
+      END_STATE();
+    case 396:
+      ACCEPT_TOKEN(sym_weight);
+      if (lookahead == '/') ADVANCE(37);
+      if (lookahead == 'a') ADVANCE(138);
+      END_STATE();
+    case 397:
+      ACCEPT_TOKEN(sym_weight);
+      if (lookahead == '/') ADVANCE(37);
+      if (lookahead == 'o') ADVANCE(179);
+      END_STATE();
+    case 398:
+      ACCEPT_TOKEN(sym_weight);
+      if (lookahead == '/') ADVANCE(37);
+      if (lookahead == 'r') ADVANCE(396);
+      END_STATE();
+    case 399:
+      ACCEPT_TOKEN(sym_weight);
+      if (lookahead == 'a') ADVANCE(139);
+      END_STATE();
+    case 400:
+      ACCEPT_TOKEN(sym_weight);
+      if (lookahead == 'o') ADVANCE(187);
+      END_STATE();
+    case 401:
+      ACCEPT_TOKEN(sym_weight);
+      if (lookahead == 'r') ADVANCE(399);
+      END_STATE();
+    case 402:
+      ACCEPT_TOKEN(sym_percent);
+      END_STATE();
+    case 403:
+      ACCEPT_TOKEN(sym_rep_scheme);
+      END_STATE();
+    case 404:
+      ACCEPT_TOKEN(sym_rep_scheme);
+      if (lookahead == '(') ADVANCE(299);
+      END_STATE();
+    case 405:
+      ACCEPT_TOKEN(sym_rep_scheme);
+      if (lookahead == '(') ADVANCE(309);
+      if (lookahead == '/') ADVANCE(36);
+      END_STATE();
+    case 406:
       ACCEPT_TOKEN(sym_rep_scheme);
       ADVANCE_MAP(
-        '.', 178,
-        '/', 18,
-        'c', 33,
-        'g', 231,
-        'k', 67,
-        'l', 35,
-        'o', 164,
-        'p', 131,
-        's', 157,
-        't', 230,
+        '.', 295,
+        '/', 35,
+        'c', 69,
+        'g', 398,
+        'k', 119,
+        'l', 72,
+        'o', 266,
+        'p', 208,
+        's', 255,
+        't', 397,
       );
-      if (('0' <= lookahead && lookahead <= '9')) ADVANCE(232);
+      if (('0' <= lookahead && lookahead <= '9')) ADVANCE(406);
       END_STATE();
-    case 233:
+    case 407:
       ACCEPT_TOKEN(sym_rep_scheme);
-      if (('0' <= lookahead && lookahead <= '9')) ADVANCE(233);
+      if (lookahead == '/') ADVANCE(36);
       END_STATE();
-    case 234:
+    case 408:
+      ACCEPT_TOKEN(sym_rep_scheme);
+      if (lookahead == '/') ADVANCE(36);
+      if (('0' <= lookahead && lookahead <= '9')) ADVANCE(408);
+      END_STATE();
+    case 409:
+      ACCEPT_TOKEN(sym_rep_scheme);
+      if (('0' <= lookahead && lookahead <= '9')) ADVANCE(409);
+      END_STATE();
+    case 410:
+      ACCEPT_TOKEN(sym_effort);
+      if (lookahead == '.') ADVANCE(290);
+      if (('0' <= lookahead && lookahead <= '9')) ADVANCE(410);
+      END_STATE();
+    case 411:
+      ACCEPT_TOKEN(sym_effort);
+      if (('0' <= lookahead && lookahead <= '9')) ADVANCE(411);
+      END_STATE();
+    case 412:
+      ACCEPT_TOKEN(sym_rest);
+      END_STATE();
+    case 413:
+      ACCEPT_TOKEN(sym_rest);
+      if (lookahead == 'i') ADVANCE(168);
+      END_STATE();
+    case 414:
+      ACCEPT_TOKEN(sym_rest);
+      if (lookahead == 'i') ADVANCE(168);
+      if (('0' <= lookahead && lookahead <= '9')) ADVANCE(26);
+      END_STATE();
+    case 415:
+      ACCEPT_TOKEN(sym_tempo);
+      if (('0' <= lookahead && lookahead <= '9')) ADVANCE(415);
+      END_STATE();
+    case 416:
+      ACCEPT_TOKEN(sym_velocity);
+      if (lookahead == '.') ADVANCE(292);
+      if (('0' <= lookahead && lookahead <= '9')) ADVANCE(416);
+      END_STATE();
+    case 417:
+      ACCEPT_TOKEN(sym_velocity);
+      if (('0' <= lookahead && lookahead <= '9')) ADVANCE(417);
+      END_STATE();
+    case 418:
+      ACCEPT_TOKEN(sym_warmup);
+      END_STATE();
+    case 419:
+      ACCEPT_TOKEN(sym_set_tags);
+      END_STATE();
+    case 420:
       ACCEPT_TOKEN(sym_duration);
       END_STATE();
-    case 235:
+    case 421:
       ACCEPT_TOKEN(sym_duration);
-      if (('0' <= lookahead && lookahead <= '9')) ADVANCE(20);
+      if (('0' <= lookahead && lookahead <= '9')) ADVANCE(40);
       END_STATE();
-    case 236:
+    case 422:
       ACCEPT_TOKEN(sym_duration);
-      if (('0' <= lookahead && lookahead <= '9')) ADVANCE(10);
+      if (('0' <= lookahead && lookahead <= '9')) ADVANCE(21);
       END_STATE();
-    case 237:
+    case 423:
       ACCEPT_TOKEN(sym_time_of_day);
       END_STATE();
-    case 238:
+    case 424:
       ACCEPT_TOKEN(sym_distance);
       END_STATE();
-    case 239:
+    case 425:
       ACCEPT_TOKEN(sym_distance);
-      if (lookahead == 'c') ADVANCE(74);
+      if (lookahead == 'c') ADVANCE(129);
       END_STATE();
-    case 240:
+    case 426:
       ACCEPT_TOKEN(sym_distance);
-      if (lookahead == 'e') ADVANCE(148);
-      if (lookahead == 'i') ADVANCE(241);
-      if (lookahead == 'm') ADVANCE(238);
+      if (lookahead == 'e') ADVANCE(240);
+      if (lookahead == 'i') ADVANCE(427);
+      if (lookahead == 'm') ADVANCE(424);
       END_STATE();
-    case 241:
+    case 427:
       ACCEPT_TOKEN(sym_distance);
-      if (lookahead == 'l') ADVANCE(54);
+      if (lookahead == 'l') ADVANCE(97);
       END_STATE();
-    case 242:
+    case 428:
       ACCEPT_TOKEN(sym_quoted_string);
       END_STATE();
     default:
@@ -1738,96 +2864,162 @@ static bool ts_lex(TSLexer *lexer, TSStateId state) {
 
 static const TSLexerMode ts_lex_modes[STATE_COUNT] = {
   [0] = {.lex_state = 0},
-  [1] = {.lex_state = 0},
-  [2] = {.lex_state = 0},
-  [3] = {.lex_state = 0},
-  [4] = {.lex_state = 187},
-  [5] = {.lex_state = 187},
-  [6] = {.lex_state = 187},
-  [7] = {.lex_state = 187},
-  [8] = {.lex_state = 187},
-  [9] = {.lex_state = 187},
-  [10] = {.lex_state = 187},
-  [11] = {.lex_state = 187},
-  [12] = {.lex_state = 187},
-  [13] = {.lex_state = 187},
-  [14] = {.lex_state = 0},
-  [15] = {.lex_state = 0},
-  [16] = {.lex_state = 0},
-  [17] = {.lex_state = 0},
-  [18] = {.lex_state = 0},
-  [19] = {.lex_state = 0},
-  [20] = {.lex_state = 0},
-  [21] = {.lex_state = 0},
-  [22] = {.lex_state = 0},
-  [23] = {.lex_state = 0},
-  [24] = {.lex_state = 0},
-  [25] = {.lex_state = 0},
-  [26] = {.lex_state = 0},
-  [27] = {.lex_state = 0},
-  [28] = {.lex_state = 0},
-  [29] = {.lex_state = 0},
-  [30] = {.lex_state = 0},
-  [31] = {.lex_state = 0},
-  [32] = {.lex_state = 0},
-  [33] = {.lex_state = 0},
-  [34] = {.lex_state = 0},
-  [35] = {.lex_state = 0},
-  [36] = {.lex_state = 0},
-  [37] = {.lex_state = 0},
-  [38] = {.lex_state = 0},
-  [39] = {.lex_state = 0},
-  [40] = {.lex_state = 0},
-  [41] = {.lex_state = 0},
-  [42] = {.lex_state = 0},
-  [43] = {.lex_state = 0},
-  [44] = {.lex_state = 16},
-  [45] = {.lex_state = 16},
-  [46] = {.lex_state = 16},
-  [47] = {.lex_state = 16},
-  [48] = {.lex_state = 187},
-  [49] = {.lex_state = 16},
-  [50] = {.lex_state = 187},
-  [51] = {.lex_state = 17},
-  [52] = {.lex_state = 17},
-  [53] = {.lex_state = 17},
-  [54] = {.lex_state = 1},
-  [55] = {.lex_state = 16},
-  [56] = {.lex_state = 0},
-  [57] = {.lex_state = 16},
-  [58] = {.lex_state = 0},
-  [59] = {.lex_state = 170},
-  [60] = {.lex_state = 1},
-  [61] = {.lex_state = 0},
-  [62] = {.lex_state = 17},
-  [63] = {.lex_state = 1},
-  [64] = {.lex_state = 17},
-  [65] = {.lex_state = 0},
-  [66] = {.lex_state = 170},
-  [67] = {.lex_state = 0},
-  [68] = {.lex_state = 0},
-  [69] = {.lex_state = 0},
-  [70] = {.lex_state = 187},
-  [71] = {.lex_state = 0},
-  [72] = {.lex_state = 0},
-  [73] = {.lex_state = 0},
-  [74] = {.lex_state = 187},
-  [75] = {.lex_state = 0},
-  [76] = {.lex_state = 0},
-  [77] = {.lex_state = 170},
-  [78] = {.lex_state = 0},
-  [79] = {.lex_state = 0},
-  [80] = {.lex_state = 0},
-  [81] = {.lex_state = 0},
-  [82] = {.lex_state = 187},
-  [83] = {.lex_state = 0},
-  [84] = {.lex_state = 187},
-  [85] = {.lex_state = 171},
-  [86] = {.lex_state = 0},
-  [87] = {.lex_state = 0},
-  [88] = {.lex_state = 0},
-  [89] = {.lex_state = 0},
-  [90] = {.lex_state = 1},
+  [1] = {.lex_state = 321},
+  [2] = {.lex_state = 321},
+  [3] = {.lex_state = 321},
+  [4] = {.lex_state = 321},
+  [5] = {.lex_state = 321},
+  [6] = {.lex_state = 321},
+  [7] = {.lex_state = 321},
+  [8] = {.lex_state = 321},
+  [9] = {.lex_state = 321},
+  [10] = {.lex_state = 321},
+  [11] = {.lex_state = 321},
+  [12] = {.lex_state = 321},
+  [13] = {.lex_state = 321},
+  [14] = {.lex_state = 321},
+  [15] = {.lex_state = 321},
+  [16] = {.lex_state = 321},
+  [17] = {.lex_state = 321},
+  [18] = {.lex_state = 321},
+  [19] = {.lex_state = 1},
+  [20] = {.lex_state = 1},
+  [21] = {.lex_state = 1},
+  [22] = {.lex_state = 321},
+  [23] = {.lex_state = 1},
+  [24] = {.lex_state = 1},
+  [25] = {.lex_state = 1},
+  [26] = {.lex_state = 1},
+  [27] = {.lex_state = 1},
+  [28] = {.lex_state = 1},
+  [29] = {.lex_state = 1},
+  [30] = {.lex_state = 1},
+  [31] = {.lex_state = 1},
+  [32] = {.lex_state = 1},
+  [33] = {.lex_state = 1},
+  [34] = {.lex_state = 1},
+  [35] = {.lex_state = 1},
+  [36] = {.lex_state = 1},
+  [37] = {.lex_state = 321},
+  [38] = {.lex_state = 321},
+  [39] = {.lex_state = 321},
+  [40] = {.lex_state = 321},
+  [41] = {.lex_state = 321},
+  [42] = {.lex_state = 321},
+  [43] = {.lex_state = 321},
+  [44] = {.lex_state = 321},
+  [45] = {.lex_state = 321},
+  [46] = {.lex_state = 321},
+  [47] = {.lex_state = 321},
+  [48] = {.lex_state = 321},
+  [49] = {.lex_state = 321},
+  [50] = {.lex_state = 321},
+  [51] = {.lex_state = 321},
+  [52] = {.lex_state = 321},
+  [53] = {.lex_state = 321},
+  [54] = {.lex_state = 321},
+  [55] = {.lex_state = 321},
+  [56] = {.lex_state = 321},
+  [57] = {.lex_state = 321},
+  [58] = {.lex_state = 321},
+  [59] = {.lex_state = 321},
+  [60] = {.lex_state = 321},
+  [61] = {.lex_state = 321},
+  [62] = {.lex_state = 321},
+  [63] = {.lex_state = 321},
+  [64] = {.lex_state = 321},
+  [65] = {.lex_state = 2},
+  [66] = {.lex_state = 321},
+  [67] = {.lex_state = 321},
+  [68] = {.lex_state = 321},
+  [69] = {.lex_state = 321},
+  [70] = {.lex_state = 321},
+  [71] = {.lex_state = 321},
+  [72] = {.lex_state = 321},
+  [73] = {.lex_state = 2},
+  [74] = {.lex_state = 321},
+  [75] = {.lex_state = 321},
+  [76] = {.lex_state = 321},
+  [77] = {.lex_state = 2},
+  [78] = {.lex_state = 321},
+  [79] = {.lex_state = 321},
+  [80] = {.lex_state = 321},
+  [81] = {.lex_state = 321},
+  [82] = {.lex_state = 321},
+  [83] = {.lex_state = 321},
+  [84] = {.lex_state = 321},
+  [85] = {.lex_state = 321},
+  [86] = {.lex_state = 2},
+  [87] = {.lex_state = 2},
+  [88] = {.lex_state = 7},
+  [89] = {.lex_state = 30},
+  [90] = {.lex_state = 30},
+  [91] = {.lex_state = 2},
+  [92] = {.lex_state = 2},
+  [93] = {.lex_state = 2},
+  [94] = {.lex_state = 30},
+  [95] = {.lex_state = 31},
+  [96] = {.lex_state = 31},
+  [97] = {.lex_state = 31},
+  [98] = {.lex_state = 31},
+  [99] = {.lex_state = 31},
+  [100] = {.lex_state = 278},
+  [101] = {.lex_state = 3},
+  [102] = {.lex_state = 30},
+  [103] = {.lex_state = 30},
+  [104] = {.lex_state = 0},
+  [105] = {.lex_state = 30},
+  [106] = {.lex_state = 278},
+  [107] = {.lex_state = 31},
+  [108] = {.lex_state = 0},
+  [109] = {.lex_state = 31},
+  [110] = {.lex_state = 278},
+  [111] = {.lex_state = 0},
+  [112] = {.lex_state = 278},
+  [113] = {.lex_state = 278},
+  [114] = {.lex_state = 0},
+  [115] = {.lex_state = 3},
+  [116] = {.lex_state = 3},
+  [117] = {.lex_state = 31},
+  [118] = {.lex_state = 31},
+  [119] = {.lex_state = 0},
+  [120] = {.lex_state = 0},
+  [121] = {.lex_state = 0},
+  [122] = {.lex_state = 0},
+  [123] = {.lex_state = 321},
+  [124] = {.lex_state = 0},
+  [125] = {.lex_state = 321},
+  [126] = {.lex_state = 3},
+  [127] = {.lex_state = 0},
+  [128] = {.lex_state = 0},
+  [129] = {.lex_state = 321},
+  [130] = {.lex_state = 278},
+  [131] = {.lex_state = 0},
+  [132] = {.lex_state = 0},
+  [133] = {.lex_state = 0},
+  [134] = {.lex_state = 0},
+  [135] = {.lex_state = 279},
+  [136] = {.lex_state = 0},
+  [137] = {.lex_state = 0},
+  [138] = {.lex_state = 0},
+  [139] = {.lex_state = 0},
+  [140] = {.lex_state = 0},
+  [141] = {.lex_state = 0},
+  [142] = {.lex_state = 0},
+  [143] = {.lex_state = 0},
+  [144] = {.lex_state = 0},
+  [145] = {.lex_state = 321},
+  [146] = {.lex_state = 0},
+  [147] = {.lex_state = 0},
+  [148] = {.lex_state = 0},
+  [149] = {.lex_state = 0},
+  [150] = {.lex_state = 0},
+  [151] = {.lex_state = 321},
+  [152] = {.lex_state = 0},
+  [153] = {.lex_state = 0},
+  [154] = {.lex_state = 0},
+  [155] = {.lex_state = 0},
+  [156] = {.lex_state = 321},
 };
 
 static const uint16_t ts_parse_table[LARGE_STATE_COUNT][SYMBOL_COUNT] = {
@@ -1836,1144 +3028,2500 @@ static const uint16_t ts_parse_table[LARGE_STATE_COUNT][SYMBOL_COUNT] = {
     [anon_sym_LF] = ACTIONS(1),
     [anon_sym_ATinclude] = ACTIONS(1),
     [anon_sym_ATplugin] = ACTIONS(1),
+    [anon_sym_ATunits] = ACTIONS(1),
+    [anon_sym_ATbodyweight] = ACTIONS(1),
+    [anon_sym_ATgoal] = ACTIONS(1),
     [anon_sym_DQUOTE] = ACTIONS(1),
     [sym_comment] = ACTIONS(1),
     [anon_sym_COLON] = ACTIONS(1),
     [anon_sym_note] = ACTIONS(1),
     [anon_sym_W] = ACTIONS(1),
+    [anon_sym_rest] = ACTIONS(1),
     [anon_sym_query] = ACTIONS(1),
     [anon_sym_ATsession] = ACTIONS(1),
     [anon_sym_ATend] = ACTIONS(1),
+    [anon_sym_ATsuperset] = ACTIONS(1),
+    [anon_sym_ATcircuit] = ACTIONS(1),
     [anon_sym_ATmovement] = ACTIONS(1),
     [anon_sym_ATtemplate] = ACTIONS(1),
     [anon_sym_note_COLON] = ACTIONS(1),
-    [sym_date] = ACTIONS(1),
     [anon_sym_STAR] = ACTIONS(1),
     [anon_sym_BANG] = ACTIONS(1),
     [sym_weight] = ACTIONS(1),
+    [sym_percent] = ACTIONS(1),
     [sym_rep_scheme] = ACTIONS(1),
+    [sym_effort] = ACTIONS(1),
+    [sym_tempo] = ACTIONS(1),
+    [sym_velocity] = ACTIONS(1),
+    [sym_warmup] = ACTIONS(1),
+    [sym_set_tags] = ACTIONS(1),
     [sym_duration] = ACTIONS(1),
     [sym_time_of_day] = ACTIONS(1),
     [sym_distance] = ACTIONS(1),
   },
   [STATE(1)] = {
-    [sym_source_file] = STATE(72),
-    [sym__entry] = STATE(3),
-    [sym_include_directive] = STATE(3),
-    [sym_plugin_directive] = STATE(3),
-    [sym_singleline_entry] = STATE(3),
-    [sym_note_entry] = STATE(3),
-    [sym_weigh_in_entry] = STATE(3),
-    [sym_query_entry] = STATE(3),
-    [sym_session_block] = STATE(3),
-    [sym_movement_block] = STATE(3),
-    [sym_template_block] = STATE(3),
-    [aux_sym_source_file_repeat1] = STATE(3),
+    [sym_source_file] = STATE(152),
+    [sym__entry] = STATE(2),
+    [sym_include_directive] = STATE(2),
+    [sym_plugin_directive] = STATE(2),
+    [sym_units_directive] = STATE(2),
+    [sym_bodyweight_directive] = STATE(2),
+    [sym_goal_directive] = STATE(2),
+    [sym_singleline_entry] = STATE(2),
+    [sym_note_entry] = STATE(2),
+    [sym_weigh_in_entry] = STATE(2),
+    [sym_rest_entry] = STATE(2),
+    [sym_query_entry] = STATE(2),
+    [sym_session_block] = STATE(2),
+    [sym_movement_block] = STATE(2),
+    [sym_template_block] = STATE(2),
+    [aux_sym_source_file_repeat1] = STATE(2),
     [ts_builtin_sym_end] = ACTIONS(3),
     [anon_sym_LF] = ACTIONS(5),
     [anon_sym_ATinclude] = ACTIONS(7),
     [anon_sym_ATplugin] = ACTIONS(9),
+    [anon_sym_ATunits] = ACTIONS(11),
+    [anon_sym_ATbodyweight] = ACTIONS(13),
+    [anon_sym_ATgoal] = ACTIONS(15),
     [sym_comment] = ACTIONS(5),
-    [anon_sym_ATsession] = ACTIONS(11),
-    [anon_sym_ATmovement] = ACTIONS(13),
-    [anon_sym_ATtemplate] = ACTIONS(15),
-    [sym_date] = ACTIONS(17),
+    [anon_sym_ATsession] = ACTIONS(17),
+    [anon_sym_ATmovement] = ACTIONS(19),
+    [anon_sym_ATtemplate] = ACTIONS(21),
+    [sym_date] = ACTIONS(23),
   },
 };
 
 static const uint16_t ts_small_parse_table[] = {
-  [0] = 9,
+  [0] = 12,
+    ACTIONS(7), 1,
+      anon_sym_ATinclude,
+    ACTIONS(9), 1,
+      anon_sym_ATplugin,
+    ACTIONS(11), 1,
+      anon_sym_ATunits,
+    ACTIONS(13), 1,
+      anon_sym_ATbodyweight,
+    ACTIONS(15), 1,
+      anon_sym_ATgoal,
+    ACTIONS(17), 1,
+      anon_sym_ATsession,
     ACTIONS(19), 1,
+      anon_sym_ATmovement,
+    ACTIONS(21), 1,
+      anon_sym_ATtemplate,
+    ACTIONS(23), 1,
+      sym_date,
+    ACTIONS(25), 1,
+      ts_builtin_sym_end,
+    ACTIONS(27), 2,
+      anon_sym_LF,
+      sym_comment,
+    STATE(3), 15,
+      sym__entry,
+      sym_include_directive,
+      sym_plugin_directive,
+      sym_units_directive,
+      sym_bodyweight_directive,
+      sym_goal_directive,
+      sym_singleline_entry,
+      sym_note_entry,
+      sym_weigh_in_entry,
+      sym_rest_entry,
+      sym_query_entry,
+      sym_session_block,
+      sym_movement_block,
+      sym_template_block,
+      aux_sym_source_file_repeat1,
+  [52] = 12,
+    ACTIONS(29), 1,
+      ts_builtin_sym_end,
+    ACTIONS(34), 1,
+      anon_sym_ATinclude,
+    ACTIONS(37), 1,
+      anon_sym_ATplugin,
+    ACTIONS(40), 1,
+      anon_sym_ATunits,
+    ACTIONS(43), 1,
+      anon_sym_ATbodyweight,
+    ACTIONS(46), 1,
+      anon_sym_ATgoal,
+    ACTIONS(49), 1,
+      anon_sym_ATsession,
+    ACTIONS(52), 1,
+      anon_sym_ATmovement,
+    ACTIONS(55), 1,
+      anon_sym_ATtemplate,
+    ACTIONS(58), 1,
+      sym_date,
+    ACTIONS(31), 2,
+      anon_sym_LF,
+      sym_comment,
+    STATE(3), 15,
+      sym__entry,
+      sym_include_directive,
+      sym_plugin_directive,
+      sym_units_directive,
+      sym_bodyweight_directive,
+      sym_goal_directive,
+      sym_singleline_entry,
+      sym_note_entry,
+      sym_weigh_in_entry,
+      sym_rest_entry,
+      sym_query_entry,
+      sym_session_block,
+      sym_movement_block,
+      sym_template_block,
+      aux_sym_source_file_repeat1,
+  [104] = 17,
+    ACTIONS(63), 1,
+      anon_sym_LF,
+    ACTIONS(65), 1,
+      sym_comment,
+    ACTIONS(67), 1,
+      sym_weight,
+    ACTIONS(69), 1,
+      sym_percent,
+    ACTIONS(71), 1,
+      sym_rep_scheme,
+    ACTIONS(73), 1,
+      sym_effort,
+    ACTIONS(75), 1,
+      sym_rest,
+    ACTIONS(77), 1,
+      sym_tempo,
+    ACTIONS(79), 1,
+      sym_velocity,
+    ACTIONS(81), 1,
+      sym_warmup,
+    ACTIONS(83), 1,
+      sym_set_tags,
+    ACTIONS(85), 1,
+      sym_duration,
+    ACTIONS(87), 1,
+      sym_distance,
+    ACTIONS(89), 1,
+      sym_quoted_string,
+    STATE(5), 1,
+      aux_sym_details_repeat1,
+    STATE(64), 1,
+      sym_details,
+    ACTIONS(61), 10,
+      ts_builtin_sym_end,
+      anon_sym_ATinclude,
+      anon_sym_ATplugin,
+      anon_sym_ATunits,
+      anon_sym_ATbodyweight,
+      anon_sym_ATgoal,
+      anon_sym_ATsession,
+      anon_sym_ATmovement,
+      anon_sym_ATtemplate,
+      sym_date,
+  [165] = 14,
+    ACTIONS(67), 1,
+      sym_weight,
+    ACTIONS(69), 1,
+      sym_percent,
+    ACTIONS(71), 1,
+      sym_rep_scheme,
+    ACTIONS(73), 1,
+      sym_effort,
+    ACTIONS(75), 1,
+      sym_rest,
+    ACTIONS(77), 1,
+      sym_tempo,
+    ACTIONS(79), 1,
+      sym_velocity,
+    ACTIONS(81), 1,
+      sym_warmup,
+    ACTIONS(83), 1,
+      sym_set_tags,
+    ACTIONS(85), 1,
+      sym_duration,
+    ACTIONS(87), 1,
+      sym_distance,
+    ACTIONS(89), 1,
+      sym_quoted_string,
+    STATE(6), 1,
+      aux_sym_details_repeat1,
+    ACTIONS(91), 12,
+      ts_builtin_sym_end,
+      anon_sym_LF,
+      anon_sym_ATinclude,
+      anon_sym_ATplugin,
+      anon_sym_ATunits,
+      anon_sym_ATbodyweight,
+      anon_sym_ATgoal,
+      sym_comment,
+      anon_sym_ATsession,
+      anon_sym_ATmovement,
+      anon_sym_ATtemplate,
+      sym_date,
+  [219] = 14,
+    ACTIONS(95), 1,
+      sym_weight,
+    ACTIONS(98), 1,
+      sym_percent,
+    ACTIONS(101), 1,
+      sym_rep_scheme,
+    ACTIONS(104), 1,
+      sym_effort,
+    ACTIONS(107), 1,
+      sym_rest,
+    ACTIONS(110), 1,
+      sym_tempo,
+    ACTIONS(113), 1,
+      sym_velocity,
+    ACTIONS(116), 1,
+      sym_warmup,
+    ACTIONS(119), 1,
+      sym_set_tags,
+    ACTIONS(122), 1,
+      sym_duration,
+    ACTIONS(125), 1,
+      sym_distance,
+    ACTIONS(128), 1,
+      sym_quoted_string,
+    STATE(6), 1,
+      aux_sym_details_repeat1,
+    ACTIONS(93), 12,
+      ts_builtin_sym_end,
+      anon_sym_LF,
+      anon_sym_ATinclude,
+      anon_sym_ATplugin,
+      anon_sym_ATunits,
+      anon_sym_ATbodyweight,
+      anon_sym_ATgoal,
+      sym_comment,
+      anon_sym_ATsession,
+      anon_sym_ATmovement,
+      anon_sym_ATtemplate,
+      sym_date,
+  [273] = 2,
+    ACTIONS(133), 2,
+      sym_weight,
+      sym_rep_scheme,
+    ACTIONS(131), 22,
+      ts_builtin_sym_end,
+      anon_sym_LF,
+      anon_sym_ATinclude,
+      anon_sym_ATplugin,
+      anon_sym_ATunits,
+      anon_sym_ATbodyweight,
+      anon_sym_ATgoal,
+      sym_comment,
+      anon_sym_ATsession,
+      anon_sym_ATmovement,
+      anon_sym_ATtemplate,
+      sym_date,
+      sym_percent,
+      sym_effort,
+      sym_rest,
+      sym_tempo,
+      sym_velocity,
+      sym_warmup,
+      sym_set_tags,
+      sym_duration,
+      sym_distance,
+      sym_quoted_string,
+  [302] = 2,
+    ACTIONS(137), 2,
+      sym_weight,
+      sym_rep_scheme,
+    ACTIONS(135), 22,
+      ts_builtin_sym_end,
+      anon_sym_LF,
+      anon_sym_ATinclude,
+      anon_sym_ATplugin,
+      anon_sym_ATunits,
+      anon_sym_ATbodyweight,
+      anon_sym_ATgoal,
+      sym_comment,
+      anon_sym_ATsession,
+      anon_sym_ATmovement,
+      anon_sym_ATtemplate,
+      sym_date,
+      sym_percent,
+      sym_effort,
+      sym_rest,
+      sym_tempo,
+      sym_velocity,
+      sym_warmup,
+      sym_set_tags,
+      sym_duration,
+      sym_distance,
+      sym_quoted_string,
+  [331] = 2,
+    ACTIONS(141), 2,
+      sym_weight,
+      sym_rep_scheme,
+    ACTIONS(139), 22,
+      ts_builtin_sym_end,
+      anon_sym_LF,
+      anon_sym_ATinclude,
+      anon_sym_ATplugin,
+      anon_sym_ATunits,
+      anon_sym_ATbodyweight,
+      anon_sym_ATgoal,
+      sym_comment,
+      anon_sym_ATsession,
+      anon_sym_ATmovement,
+      anon_sym_ATtemplate,
+      sym_date,
+      sym_percent,
+      sym_effort,
+      sym_rest,
+      sym_tempo,
+      sym_velocity,
+      sym_warmup,
+      sym_set_tags,
+      sym_duration,
+      sym_distance,
+      sym_quoted_string,
+  [360] = 2,
+    ACTIONS(145), 2,
+      sym_weight,
+      sym_rep_scheme,
+    ACTIONS(143), 22,
+      ts_builtin_sym_end,
+      anon_sym_LF,
+      anon_sym_ATinclude,
+      anon_sym_ATplugin,
+      anon_sym_ATunits,
+      anon_sym_ATbodyweight,
+      anon_sym_ATgoal,
+      sym_comment,
+      anon_sym_ATsession,
+      anon_sym_ATmovement,
+      anon_sym_ATtemplate,
+      sym_date,
+      sym_percent,
+      sym_effort,
+      sym_rest,
+      sym_tempo,
+      sym_velocity,
+      sym_warmup,
+      sym_set_tags,
+      sym_duration,
+      sym_distance,
+      sym_quoted_string,
+  [389] = 2,
+    ACTIONS(149), 2,
+      sym_weight,
+      sym_rep_scheme,
+    ACTIONS(147), 22,
+      ts_builtin_sym_end,
+      anon_sym_LF,
+      anon_sym_ATinclude,
+      anon_sym_ATplugin,
+      anon_sym_ATunits,
+      anon_sym_ATbodyweight,
+      anon_sym_ATgoal,
+      sym_comment,
+      anon_sym_ATsession,
+      anon_sym_ATmovement,
+      anon_sym_ATtemplate,
+      sym_date,
+      sym_percent,
+      sym_effort,
+      sym_rest,
+      sym_tempo,
+      sym_velocity,
+      sym_warmup,
+      sym_set_tags,
+      sym_duration,
+      sym_distance,
+      sym_quoted_string,
+  [418] = 2,
+    ACTIONS(153), 2,
+      sym_weight,
+      sym_rep_scheme,
+    ACTIONS(151), 22,
+      ts_builtin_sym_end,
+      anon_sym_LF,
+      anon_sym_ATinclude,
+      anon_sym_ATplugin,
+      anon_sym_ATunits,
+      anon_sym_ATbodyweight,
+      anon_sym_ATgoal,
+      sym_comment,
+      anon_sym_ATsession,
+      anon_sym_ATmovement,
+      anon_sym_ATtemplate,
+      sym_date,
+      sym_percent,
+      sym_effort,
+      sym_rest,
+      sym_tempo,
+      sym_velocity,
+      sym_warmup,
+      sym_set_tags,
+      sym_duration,
+      sym_distance,
+      sym_quoted_string,
+  [447] = 2,
+    ACTIONS(157), 2,
+      sym_weight,
+      sym_rep_scheme,
+    ACTIONS(155), 22,
+      ts_builtin_sym_end,
+      anon_sym_LF,
+      anon_sym_ATinclude,
+      anon_sym_ATplugin,
+      anon_sym_ATunits,
+      anon_sym_ATbodyweight,
+      anon_sym_ATgoal,
+      sym_comment,
+      anon_sym_ATsession,
+      anon_sym_ATmovement,
+      anon_sym_ATtemplate,
+      sym_date,
+      sym_percent,
+      sym_effort,
+      sym_rest,
+      sym_tempo,
+      sym_velocity,
+      sym_warmup,
+      sym_set_tags,
+      sym_duration,
+      sym_distance,
+      sym_quoted_string,
+  [476] = 2,
+    ACTIONS(161), 2,
+      sym_weight,
+      sym_rep_scheme,
+    ACTIONS(159), 22,
+      ts_builtin_sym_end,
+      anon_sym_LF,
+      anon_sym_ATinclude,
+      anon_sym_ATplugin,
+      anon_sym_ATunits,
+      anon_sym_ATbodyweight,
+      anon_sym_ATgoal,
+      sym_comment,
+      anon_sym_ATsession,
+      anon_sym_ATmovement,
+      anon_sym_ATtemplate,
+      sym_date,
+      sym_percent,
+      sym_effort,
+      sym_rest,
+      sym_tempo,
+      sym_velocity,
+      sym_warmup,
+      sym_set_tags,
+      sym_duration,
+      sym_distance,
+      sym_quoted_string,
+  [505] = 2,
+    ACTIONS(165), 2,
+      sym_weight,
+      sym_rep_scheme,
+    ACTIONS(163), 22,
+      ts_builtin_sym_end,
+      anon_sym_LF,
+      anon_sym_ATinclude,
+      anon_sym_ATplugin,
+      anon_sym_ATunits,
+      anon_sym_ATbodyweight,
+      anon_sym_ATgoal,
+      sym_comment,
+      anon_sym_ATsession,
+      anon_sym_ATmovement,
+      anon_sym_ATtemplate,
+      sym_date,
+      sym_percent,
+      sym_effort,
+      sym_rest,
+      sym_tempo,
+      sym_velocity,
+      sym_warmup,
+      sym_set_tags,
+      sym_duration,
+      sym_distance,
+      sym_quoted_string,
+  [534] = 2,
+    ACTIONS(169), 2,
+      sym_weight,
+      sym_rep_scheme,
+    ACTIONS(167), 22,
+      ts_builtin_sym_end,
+      anon_sym_LF,
+      anon_sym_ATinclude,
+      anon_sym_ATplugin,
+      anon_sym_ATunits,
+      anon_sym_ATbodyweight,
+      anon_sym_ATgoal,
+      sym_comment,
+      anon_sym_ATsession,
+      anon_sym_ATmovement,
+      anon_sym_ATtemplate,
+      sym_date,
+      sym_percent,
+      sym_effort,
+      sym_rest,
+      sym_tempo,
+      sym_velocity,
+      sym_warmup,
+      sym_set_tags,
+      sym_duration,
+      sym_distance,
+      sym_quoted_string,
+  [563] = 2,
+    ACTIONS(173), 2,
+      sym_weight,
+      sym_rep_scheme,
+    ACTIONS(171), 22,
       ts_builtin_sym_end,
-    ACTIONS(24), 1,
+      anon_sym_LF,
       anon_sym_ATinclude,
-    ACTIONS(27), 1,
       anon_sym_ATplugin,
-    ACTIONS(30), 1,
+      anon_sym_ATunits,
+      anon_sym_ATbodyweight,
+      anon_sym_ATgoal,
+      sym_comment,
       anon_sym_ATsession,
-    ACTIONS(33), 1,
       anon_sym_ATmovement,
-    ACTIONS(36), 1,
       anon_sym_ATtemplate,
-    ACTIONS(39), 1,
       sym_date,
-    ACTIONS(21), 2,
+      sym_percent,
+      sym_effort,
+      sym_rest,
+      sym_tempo,
+      sym_velocity,
+      sym_warmup,
+      sym_set_tags,
+      sym_duration,
+      sym_distance,
+      sym_quoted_string,
+  [592] = 2,
+    ACTIONS(177), 2,
+      sym_weight,
+      sym_rep_scheme,
+    ACTIONS(175), 22,
+      ts_builtin_sym_end,
       anon_sym_LF,
-      sym_comment,
-    STATE(2), 11,
-      sym__entry,
-      sym_include_directive,
-      sym_plugin_directive,
-      sym_singleline_entry,
-      sym_note_entry,
-      sym_weigh_in_entry,
-      sym_query_entry,
-      sym_session_block,
-      sym_movement_block,
-      sym_template_block,
-      aux_sym_source_file_repeat1,
-  [39] = 9,
-    ACTIONS(7), 1,
       anon_sym_ATinclude,
-    ACTIONS(9), 1,
       anon_sym_ATplugin,
-    ACTIONS(11), 1,
+      anon_sym_ATunits,
+      anon_sym_ATbodyweight,
+      anon_sym_ATgoal,
+      sym_comment,
       anon_sym_ATsession,
-    ACTIONS(13), 1,
       anon_sym_ATmovement,
-    ACTIONS(15), 1,
       anon_sym_ATtemplate,
-    ACTIONS(17), 1,
       sym_date,
-    ACTIONS(42), 1,
-      ts_builtin_sym_end,
-    ACTIONS(44), 2,
+      sym_percent,
+      sym_effort,
+      sym_rest,
+      sym_tempo,
+      sym_velocity,
+      sym_warmup,
+      sym_set_tags,
+      sym_duration,
+      sym_distance,
+      sym_quoted_string,
+  [621] = 14,
+    ACTIONS(179), 1,
+      sym_weight,
+    ACTIONS(181), 1,
+      sym_percent,
+    ACTIONS(183), 1,
+      sym_rep_scheme,
+    ACTIONS(185), 1,
+      sym_effort,
+    ACTIONS(187), 1,
+      sym_rest,
+    ACTIONS(189), 1,
+      sym_tempo,
+    ACTIONS(191), 1,
+      sym_velocity,
+    ACTIONS(193), 1,
+      sym_warmup,
+    ACTIONS(195), 1,
+      sym_set_tags,
+    ACTIONS(197), 1,
+      sym_duration,
+    ACTIONS(199), 1,
+      sym_distance,
+    ACTIONS(201), 1,
+      sym_quoted_string,
+    STATE(20), 1,
+      aux_sym_details_repeat1,
+    ACTIONS(91), 2,
       anon_sym_LF,
       sym_comment,
-    STATE(2), 11,
-      sym__entry,
-      sym_include_directive,
-      sym_plugin_directive,
-      sym_singleline_entry,
-      sym_note_entry,
-      sym_weigh_in_entry,
-      sym_query_entry,
-      sym_session_block,
-      sym_movement_block,
-      sym_template_block,
-      aux_sym_source_file_repeat1,
-  [78] = 9,
-    ACTIONS(48), 1,
+  [665] = 14,
+    ACTIONS(203), 1,
+      sym_weight,
+    ACTIONS(206), 1,
+      sym_percent,
+    ACTIONS(209), 1,
+      sym_rep_scheme,
+    ACTIONS(212), 1,
+      sym_effort,
+    ACTIONS(215), 1,
+      sym_rest,
+    ACTIONS(218), 1,
+      sym_tempo,
+    ACTIONS(221), 1,
+      sym_velocity,
+    ACTIONS(224), 1,
+      sym_warmup,
+    ACTIONS(227), 1,
+      sym_set_tags,
+    ACTIONS(230), 1,
+      sym_duration,
+    ACTIONS(233), 1,
+      sym_distance,
+    ACTIONS(236), 1,
+      sym_quoted_string,
+    STATE(20), 1,
+      aux_sym_details_repeat1,
+    ACTIONS(93), 2,
       anon_sym_LF,
-    ACTIONS(50), 1,
+      sym_comment,
+  [709] = 14,
+    ACTIONS(179), 1,
       sym_weight,
-    ACTIONS(52), 1,
+    ACTIONS(181), 1,
+      sym_percent,
+    ACTIONS(183), 1,
       sym_rep_scheme,
-    ACTIONS(54), 1,
+    ACTIONS(185), 1,
+      sym_effort,
+    ACTIONS(187), 1,
+      sym_rest,
+    ACTIONS(189), 1,
+      sym_tempo,
+    ACTIONS(191), 1,
+      sym_velocity,
+    ACTIONS(193), 1,
+      sym_warmup,
+    ACTIONS(195), 1,
+      sym_set_tags,
+    ACTIONS(197), 1,
       sym_duration,
-    ACTIONS(56), 1,
+    ACTIONS(199), 1,
       sym_distance,
-    ACTIONS(58), 1,
+    ACTIONS(201), 1,
       sym_quoted_string,
-    STATE(6), 1,
+    STATE(19), 1,
       aux_sym_details_repeat1,
-    STATE(32), 1,
+    STATE(108), 1,
       sym_details,
-    ACTIONS(46), 8,
+  [752] = 4,
+    ACTIONS(241), 1,
+      anon_sym_LF,
+    ACTIONS(243), 1,
+      sym_time_of_day,
+    ACTIONS(245), 1,
+      sym_quoted_string,
+    ACTIONS(239), 11,
       ts_builtin_sym_end,
       anon_sym_ATinclude,
       anon_sym_ATplugin,
+      anon_sym_ATunits,
+      anon_sym_ATbodyweight,
+      anon_sym_ATgoal,
       sym_comment,
       anon_sym_ATsession,
       anon_sym_ATmovement,
       anon_sym_ATtemplate,
       sym_date,
-  [113] = 7,
-    ACTIONS(62), 1,
+  [775] = 2,
+    ACTIONS(149), 2,
       sym_weight,
-    ACTIONS(65), 1,
       sym_rep_scheme,
-    ACTIONS(68), 1,
+    ACTIONS(147), 12,
+      anon_sym_LF,
+      sym_comment,
+      sym_percent,
+      sym_effort,
+      sym_rest,
+      sym_tempo,
+      sym_velocity,
+      sym_warmup,
+      sym_set_tags,
       sym_duration,
-    ACTIONS(71), 1,
       sym_distance,
-    ACTIONS(74), 1,
       sym_quoted_string,
-    STATE(5), 1,
+  [794] = 2,
+    ACTIONS(137), 2,
+      sym_weight,
+      sym_rep_scheme,
+    ACTIONS(135), 12,
+      anon_sym_LF,
+      sym_comment,
+      sym_percent,
+      sym_effort,
+      sym_rest,
+      sym_tempo,
+      sym_velocity,
+      sym_warmup,
+      sym_set_tags,
+      sym_duration,
+      sym_distance,
+      sym_quoted_string,
+  [813] = 2,
+    ACTIONS(157), 2,
+      sym_weight,
+      sym_rep_scheme,
+    ACTIONS(155), 12,
+      anon_sym_LF,
+      sym_comment,
+      sym_percent,
+      sym_effort,
+      sym_rest,
+      sym_tempo,
+      sym_velocity,
+      sym_warmup,
+      sym_set_tags,
+      sym_duration,
+      sym_distance,
+      sym_quoted_string,
+  [832] = 2,
+    ACTIONS(153), 2,
+      sym_weight,
+      sym_rep_scheme,
+    ACTIONS(151), 12,
+      anon_sym_LF,
+      sym_comment,
+      sym_percent,
+      sym_effort,
+      sym_rest,
+      sym_tempo,
+      sym_velocity,
+      sym_warmup,
+      sym_set_tags,
+      sym_duration,
+      sym_distance,
+      sym_quoted_string,
+  [851] = 2,
+    ACTIONS(165), 2,
+      sym_weight,
+      sym_rep_scheme,
+    ACTIONS(163), 12,
+      anon_sym_LF,
+      sym_comment,
+      sym_percent,
+      sym_effort,
+      sym_rest,
+      sym_tempo,
+      sym_velocity,
+      sym_warmup,
+      sym_set_tags,
+      sym_duration,
+      sym_distance,
+      sym_quoted_string,
+  [870] = 2,
+    ACTIONS(169), 2,
+      sym_weight,
+      sym_rep_scheme,
+    ACTIONS(167), 12,
+      anon_sym_LF,
+      sym_comment,
+      sym_percent,
+      sym_effort,
+      sym_rest,
+      sym_tempo,
+      sym_velocity,
+      sym_warmup,
+      sym_set_tags,
+      sym_duration,
+      sym_distance,
+      sym_quoted_string,
+  [889] = 2,
+    ACTIONS(173), 2,
+      sym_weight,
+      sym_rep_scheme,
+    ACTIONS(171), 12,
+      anon_sym_LF,
+      sym_comment,
+      sym_percent,
+      sym_effort,
+      sym_rest,
+      sym_tempo,
+      sym_velocity,
+      sym_warmup,
+      sym_set_tags,
+      sym_duration,
+      sym_distance,
+      sym_quoted_string,
+  [908] = 2,
+    ACTIONS(141), 2,
+      sym_weight,
+      sym_rep_scheme,
+    ACTIONS(139), 12,
+      anon_sym_LF,
+      sym_comment,
+      sym_percent,
+      sym_effort,
+      sym_rest,
+      sym_tempo,
+      sym_velocity,
+      sym_warmup,
+      sym_set_tags,
+      sym_duration,
+      sym_distance,
+      sym_quoted_string,
+  [927] = 2,
+    ACTIONS(161), 2,
+      sym_weight,
+      sym_rep_scheme,
+    ACTIONS(159), 12,
+      anon_sym_LF,
+      sym_comment,
+      sym_percent,
+      sym_effort,
+      sym_rest,
+      sym_tempo,
+      sym_velocity,
+      sym_warmup,
+      sym_set_tags,
+      sym_duration,
+      sym_distance,
+      sym_quoted_string,
+  [946] = 2,
+    ACTIONS(133), 2,
+      sym_weight,
+      sym_rep_scheme,
+    ACTIONS(131), 12,
+      anon_sym_LF,
+      sym_comment,
+      sym_percent,
+      sym_effort,
+      sym_rest,
+      sym_tempo,
+      sym_velocity,
+      sym_warmup,
+      sym_set_tags,
+      sym_duration,
+      sym_distance,
+      sym_quoted_string,
+  [965] = 2,
+    ACTIONS(177), 2,
+      sym_weight,
+      sym_rep_scheme,
+    ACTIONS(175), 12,
+      anon_sym_LF,
+      sym_comment,
+      sym_percent,
+      sym_effort,
+      sym_rest,
+      sym_tempo,
+      sym_velocity,
+      sym_warmup,
+      sym_set_tags,
+      sym_duration,
+      sym_distance,
+      sym_quoted_string,
+  [984] = 2,
+    ACTIONS(145), 2,
+      sym_weight,
+      sym_rep_scheme,
+    ACTIONS(143), 12,
+      anon_sym_LF,
+      sym_comment,
+      sym_percent,
+      sym_effort,
+      sym_rest,
+      sym_tempo,
+      sym_velocity,
+      sym_warmup,
+      sym_set_tags,
+      sym_duration,
+      sym_distance,
+      sym_quoted_string,
+  [1003] = 14,
+    ACTIONS(179), 1,
+      sym_weight,
+    ACTIONS(181), 1,
+      sym_percent,
+    ACTIONS(183), 1,
+      sym_rep_scheme,
+    ACTIONS(185), 1,
+      sym_effort,
+    ACTIONS(187), 1,
+      sym_rest,
+    ACTIONS(189), 1,
+      sym_tempo,
+    ACTIONS(191), 1,
+      sym_velocity,
+    ACTIONS(193), 1,
+      sym_warmup,
+    ACTIONS(195), 1,
+      sym_set_tags,
+    ACTIONS(197), 1,
+      sym_duration,
+    ACTIONS(199), 1,
+      sym_distance,
+    ACTIONS(201), 1,
+      sym_quoted_string,
+    STATE(19), 1,
+      aux_sym_details_repeat1,
+    STATE(119), 1,
+      sym_details,
+  [1046] = 14,
+    ACTIONS(179), 1,
+      sym_weight,
+    ACTIONS(181), 1,
+      sym_percent,
+    ACTIONS(183), 1,
+      sym_rep_scheme,
+    ACTIONS(185), 1,
+      sym_effort,
+    ACTIONS(187), 1,
+      sym_rest,
+    ACTIONS(189), 1,
+      sym_tempo,
+    ACTIONS(191), 1,
+      sym_velocity,
+    ACTIONS(193), 1,
+      sym_warmup,
+    ACTIONS(195), 1,
+      sym_set_tags,
+    ACTIONS(197), 1,
+      sym_duration,
+    ACTIONS(199), 1,
+      sym_distance,
+    ACTIONS(201), 1,
+      sym_quoted_string,
+    STATE(19), 1,
       aux_sym_details_repeat1,
-    ACTIONS(60), 9,
+    STATE(120), 1,
+      sym_details,
+  [1089] = 3,
+    ACTIONS(249), 1,
+      anon_sym_LF,
+    ACTIONS(251), 1,
+      sym_quoted_string,
+    ACTIONS(247), 11,
+      ts_builtin_sym_end,
+      anon_sym_ATinclude,
+      anon_sym_ATplugin,
+      anon_sym_ATunits,
+      anon_sym_ATbodyweight,
+      anon_sym_ATgoal,
+      sym_comment,
+      anon_sym_ATsession,
+      anon_sym_ATmovement,
+      anon_sym_ATtemplate,
+      sym_date,
+  [1109] = 1,
+    ACTIONS(253), 13,
+      ts_builtin_sym_end,
+      anon_sym_LF,
+      anon_sym_ATinclude,
+      anon_sym_ATplugin,
+      anon_sym_ATunits,
+      anon_sym_ATbodyweight,
+      anon_sym_ATgoal,
+      sym_comment,
+      anon_sym_COLON,
+      anon_sym_ATsession,
+      anon_sym_ATmovement,
+      anon_sym_ATtemplate,
+      sym_date,
+  [1125] = 3,
+    ACTIONS(257), 1,
+      anon_sym_LF,
+    ACTIONS(259), 1,
+      sym_quoted_string,
+    ACTIONS(255), 11,
+      ts_builtin_sym_end,
+      anon_sym_ATinclude,
+      anon_sym_ATplugin,
+      anon_sym_ATunits,
+      anon_sym_ATbodyweight,
+      anon_sym_ATgoal,
+      sym_comment,
+      anon_sym_ATsession,
+      anon_sym_ATmovement,
+      anon_sym_ATtemplate,
+      sym_date,
+  [1145] = 2,
+    ACTIONS(263), 1,
+      anon_sym_LF,
+    ACTIONS(261), 11,
+      ts_builtin_sym_end,
+      anon_sym_ATinclude,
+      anon_sym_ATplugin,
+      anon_sym_ATunits,
+      anon_sym_ATbodyweight,
+      anon_sym_ATgoal,
+      sym_comment,
+      anon_sym_ATsession,
+      anon_sym_ATmovement,
+      anon_sym_ATtemplate,
+      sym_date,
+  [1162] = 2,
+    ACTIONS(267), 1,
+      anon_sym_LF,
+    ACTIONS(265), 11,
+      ts_builtin_sym_end,
+      anon_sym_ATinclude,
+      anon_sym_ATplugin,
+      anon_sym_ATunits,
+      anon_sym_ATbodyweight,
+      anon_sym_ATgoal,
+      sym_comment,
+      anon_sym_ATsession,
+      anon_sym_ATmovement,
+      anon_sym_ATtemplate,
+      sym_date,
+  [1179] = 1,
+    ACTIONS(269), 12,
+      ts_builtin_sym_end,
+      anon_sym_LF,
+      anon_sym_ATinclude,
+      anon_sym_ATplugin,
+      anon_sym_ATunits,
+      anon_sym_ATbodyweight,
+      anon_sym_ATgoal,
+      sym_comment,
+      anon_sym_ATsession,
+      anon_sym_ATmovement,
+      anon_sym_ATtemplate,
+      sym_date,
+  [1194] = 1,
+    ACTIONS(271), 12,
       ts_builtin_sym_end,
       anon_sym_LF,
       anon_sym_ATinclude,
       anon_sym_ATplugin,
+      anon_sym_ATunits,
+      anon_sym_ATbodyweight,
+      anon_sym_ATgoal,
+      sym_comment,
+      anon_sym_ATsession,
+      anon_sym_ATmovement,
+      anon_sym_ATtemplate,
+      sym_date,
+  [1209] = 2,
+    ACTIONS(275), 1,
+      anon_sym_LF,
+    ACTIONS(273), 11,
+      ts_builtin_sym_end,
+      anon_sym_ATinclude,
+      anon_sym_ATplugin,
+      anon_sym_ATunits,
+      anon_sym_ATbodyweight,
+      anon_sym_ATgoal,
       sym_comment,
       anon_sym_ATsession,
       anon_sym_ATmovement,
       anon_sym_ATtemplate,
       sym_date,
-  [143] = 7,
-    ACTIONS(50), 1,
-      sym_weight,
-    ACTIONS(52), 1,
-      sym_rep_scheme,
-    ACTIONS(54), 1,
-      sym_duration,
-    ACTIONS(56), 1,
-      sym_distance,
-    ACTIONS(58), 1,
-      sym_quoted_string,
-    STATE(5), 1,
-      aux_sym_details_repeat1,
-    ACTIONS(77), 9,
-      ts_builtin_sym_end,
+  [1226] = 2,
+    ACTIONS(279), 1,
       anon_sym_LF,
+    ACTIONS(277), 11,
+      ts_builtin_sym_end,
       anon_sym_ATinclude,
       anon_sym_ATplugin,
+      anon_sym_ATunits,
+      anon_sym_ATbodyweight,
+      anon_sym_ATgoal,
       sym_comment,
       anon_sym_ATsession,
       anon_sym_ATmovement,
       anon_sym_ATtemplate,
       sym_date,
-  [173] = 2,
-    ACTIONS(81), 1,
-      sym_rep_scheme,
-    ACTIONS(79), 13,
+  [1243] = 1,
+    ACTIONS(281), 12,
       ts_builtin_sym_end,
       anon_sym_LF,
       anon_sym_ATinclude,
       anon_sym_ATplugin,
+      anon_sym_ATunits,
+      anon_sym_ATbodyweight,
+      anon_sym_ATgoal,
       sym_comment,
       anon_sym_ATsession,
       anon_sym_ATmovement,
       anon_sym_ATtemplate,
       sym_date,
-      sym_weight,
-      sym_duration,
-      sym_distance,
-      sym_quoted_string,
-  [192] = 2,
-    ACTIONS(85), 1,
-      sym_rep_scheme,
-    ACTIONS(83), 13,
+  [1258] = 1,
+    ACTIONS(283), 12,
       ts_builtin_sym_end,
       anon_sym_LF,
       anon_sym_ATinclude,
       anon_sym_ATplugin,
+      anon_sym_ATunits,
+      anon_sym_ATbodyweight,
+      anon_sym_ATgoal,
       sym_comment,
       anon_sym_ATsession,
       anon_sym_ATmovement,
       anon_sym_ATtemplate,
       sym_date,
-      sym_weight,
-      sym_duration,
-      sym_distance,
-      sym_quoted_string,
-  [211] = 2,
-    ACTIONS(89), 1,
-      sym_rep_scheme,
-    ACTIONS(87), 13,
-      ts_builtin_sym_end,
+  [1273] = 2,
+    ACTIONS(287), 1,
       anon_sym_LF,
+    ACTIONS(285), 11,
+      ts_builtin_sym_end,
       anon_sym_ATinclude,
       anon_sym_ATplugin,
+      anon_sym_ATunits,
+      anon_sym_ATbodyweight,
+      anon_sym_ATgoal,
       sym_comment,
       anon_sym_ATsession,
       anon_sym_ATmovement,
       anon_sym_ATtemplate,
       sym_date,
-      sym_weight,
-      sym_duration,
-      sym_distance,
-      sym_quoted_string,
-  [230] = 2,
-    ACTIONS(93), 1,
-      sym_rep_scheme,
-    ACTIONS(91), 13,
+  [1290] = 1,
+    ACTIONS(289), 12,
       ts_builtin_sym_end,
       anon_sym_LF,
       anon_sym_ATinclude,
       anon_sym_ATplugin,
+      anon_sym_ATunits,
+      anon_sym_ATbodyweight,
+      anon_sym_ATgoal,
       sym_comment,
       anon_sym_ATsession,
       anon_sym_ATmovement,
       anon_sym_ATtemplate,
       sym_date,
-      sym_weight,
-      sym_duration,
-      sym_distance,
-      sym_quoted_string,
-  [249] = 2,
-    ACTIONS(97), 1,
-      sym_rep_scheme,
-    ACTIONS(95), 13,
-      ts_builtin_sym_end,
+  [1305] = 2,
+    ACTIONS(293), 1,
       anon_sym_LF,
+    ACTIONS(291), 11,
+      ts_builtin_sym_end,
       anon_sym_ATinclude,
       anon_sym_ATplugin,
+      anon_sym_ATunits,
+      anon_sym_ATbodyweight,
+      anon_sym_ATgoal,
       sym_comment,
       anon_sym_ATsession,
       anon_sym_ATmovement,
       anon_sym_ATtemplate,
       sym_date,
-      sym_weight,
-      sym_duration,
-      sym_distance,
-      sym_quoted_string,
-  [268] = 4,
-    ACTIONS(101), 1,
-      anon_sym_LF,
-    ACTIONS(103), 1,
-      sym_time_of_day,
-    ACTIONS(105), 1,
-      sym_quoted_string,
-    ACTIONS(99), 8,
+  [1322] = 1,
+    ACTIONS(295), 12,
       ts_builtin_sym_end,
+      anon_sym_LF,
       anon_sym_ATinclude,
       anon_sym_ATplugin,
+      anon_sym_ATunits,
+      anon_sym_ATbodyweight,
+      anon_sym_ATgoal,
       sym_comment,
       anon_sym_ATsession,
       anon_sym_ATmovement,
       anon_sym_ATtemplate,
       sym_date,
-  [288] = 3,
-    ACTIONS(109), 1,
+  [1337] = 2,
+    ACTIONS(297), 1,
       anon_sym_LF,
-    ACTIONS(111), 1,
-      sym_quoted_string,
-    ACTIONS(107), 8,
+    ACTIONS(295), 11,
       ts_builtin_sym_end,
       anon_sym_ATinclude,
       anon_sym_ATplugin,
+      anon_sym_ATunits,
+      anon_sym_ATbodyweight,
+      anon_sym_ATgoal,
       sym_comment,
       anon_sym_ATsession,
       anon_sym_ATmovement,
       anon_sym_ATtemplate,
       sym_date,
-  [305] = 1,
-    ACTIONS(113), 9,
+  [1354] = 1,
+    ACTIONS(299), 12,
       ts_builtin_sym_end,
       anon_sym_LF,
       anon_sym_ATinclude,
       anon_sym_ATplugin,
+      anon_sym_ATunits,
+      anon_sym_ATbodyweight,
+      anon_sym_ATgoal,
       sym_comment,
       anon_sym_ATsession,
       anon_sym_ATmovement,
       anon_sym_ATtemplate,
       sym_date,
-  [317] = 2,
-    ACTIONS(117), 1,
+  [1369] = 2,
+    ACTIONS(301), 1,
       anon_sym_LF,
-    ACTIONS(115), 8,
+    ACTIONS(299), 11,
       ts_builtin_sym_end,
       anon_sym_ATinclude,
       anon_sym_ATplugin,
+      anon_sym_ATunits,
+      anon_sym_ATbodyweight,
+      anon_sym_ATgoal,
       sym_comment,
       anon_sym_ATsession,
       anon_sym_ATmovement,
       anon_sym_ATtemplate,
       sym_date,
-  [331] = 2,
-    ACTIONS(121), 1,
-      anon_sym_LF,
-    ACTIONS(119), 8,
+  [1386] = 1,
+    ACTIONS(303), 12,
       ts_builtin_sym_end,
+      anon_sym_LF,
       anon_sym_ATinclude,
       anon_sym_ATplugin,
+      anon_sym_ATunits,
+      anon_sym_ATbodyweight,
+      anon_sym_ATgoal,
       sym_comment,
       anon_sym_ATsession,
       anon_sym_ATmovement,
       anon_sym_ATtemplate,
       sym_date,
-  [345] = 1,
-    ACTIONS(123), 9,
-      ts_builtin_sym_end,
+  [1401] = 2,
+    ACTIONS(307), 1,
       anon_sym_LF,
+    ACTIONS(305), 11,
+      ts_builtin_sym_end,
       anon_sym_ATinclude,
       anon_sym_ATplugin,
+      anon_sym_ATunits,
+      anon_sym_ATbodyweight,
+      anon_sym_ATgoal,
       sym_comment,
       anon_sym_ATsession,
       anon_sym_ATmovement,
       anon_sym_ATtemplate,
       sym_date,
-  [357] = 1,
-    ACTIONS(125), 9,
+  [1418] = 1,
+    ACTIONS(309), 12,
       ts_builtin_sym_end,
       anon_sym_LF,
       anon_sym_ATinclude,
       anon_sym_ATplugin,
+      anon_sym_ATunits,
+      anon_sym_ATbodyweight,
+      anon_sym_ATgoal,
       sym_comment,
       anon_sym_ATsession,
       anon_sym_ATmovement,
       anon_sym_ATtemplate,
       sym_date,
-  [369] = 2,
-    ACTIONS(127), 1,
-      anon_sym_LF,
-    ACTIONS(125), 8,
+  [1433] = 1,
+    ACTIONS(311), 12,
       ts_builtin_sym_end,
+      anon_sym_LF,
       anon_sym_ATinclude,
       anon_sym_ATplugin,
+      anon_sym_ATunits,
+      anon_sym_ATbodyweight,
+      anon_sym_ATgoal,
       sym_comment,
       anon_sym_ATsession,
       anon_sym_ATmovement,
       anon_sym_ATtemplate,
       sym_date,
-  [383] = 1,
-    ACTIONS(129), 9,
+  [1448] = 1,
+    ACTIONS(313), 12,
       ts_builtin_sym_end,
       anon_sym_LF,
       anon_sym_ATinclude,
       anon_sym_ATplugin,
+      anon_sym_ATunits,
+      anon_sym_ATbodyweight,
+      anon_sym_ATgoal,
       sym_comment,
       anon_sym_ATsession,
       anon_sym_ATmovement,
       anon_sym_ATtemplate,
       sym_date,
-  [395] = 2,
-    ACTIONS(131), 1,
-      anon_sym_LF,
-    ACTIONS(129), 8,
+  [1463] = 1,
+    ACTIONS(315), 12,
       ts_builtin_sym_end,
+      anon_sym_LF,
       anon_sym_ATinclude,
       anon_sym_ATplugin,
+      anon_sym_ATunits,
+      anon_sym_ATbodyweight,
+      anon_sym_ATgoal,
       sym_comment,
       anon_sym_ATsession,
       anon_sym_ATmovement,
       anon_sym_ATtemplate,
       sym_date,
-  [409] = 1,
-    ACTIONS(133), 9,
-      ts_builtin_sym_end,
+  [1478] = 2,
+    ACTIONS(319), 1,
       anon_sym_LF,
+    ACTIONS(317), 11,
+      ts_builtin_sym_end,
       anon_sym_ATinclude,
       anon_sym_ATplugin,
+      anon_sym_ATunits,
+      anon_sym_ATbodyweight,
+      anon_sym_ATgoal,
       sym_comment,
       anon_sym_ATsession,
       anon_sym_ATmovement,
       anon_sym_ATtemplate,
       sym_date,
-  [421] = 2,
-    ACTIONS(137), 1,
+  [1495] = 2,
+    ACTIONS(323), 1,
       anon_sym_LF,
-    ACTIONS(135), 8,
+    ACTIONS(321), 11,
       ts_builtin_sym_end,
       anon_sym_ATinclude,
       anon_sym_ATplugin,
+      anon_sym_ATunits,
+      anon_sym_ATbodyweight,
+      anon_sym_ATgoal,
       sym_comment,
       anon_sym_ATsession,
       anon_sym_ATmovement,
       anon_sym_ATtemplate,
       sym_date,
-  [435] = 1,
-    ACTIONS(139), 9,
-      ts_builtin_sym_end,
+  [1512] = 2,
+    ACTIONS(327), 1,
       anon_sym_LF,
+    ACTIONS(325), 11,
+      ts_builtin_sym_end,
       anon_sym_ATinclude,
       anon_sym_ATplugin,
+      anon_sym_ATunits,
+      anon_sym_ATbodyweight,
+      anon_sym_ATgoal,
       sym_comment,
       anon_sym_ATsession,
       anon_sym_ATmovement,
       anon_sym_ATtemplate,
       sym_date,
-  [447] = 1,
-    ACTIONS(141), 9,
-      ts_builtin_sym_end,
+  [1529] = 3,
+    ACTIONS(331), 1,
       anon_sym_LF,
+    ACTIONS(333), 1,
+      sym_comment,
+    ACTIONS(329), 10,
+      ts_builtin_sym_end,
       anon_sym_ATinclude,
       anon_sym_ATplugin,
-      sym_comment,
+      anon_sym_ATunits,
+      anon_sym_ATbodyweight,
+      anon_sym_ATgoal,
       anon_sym_ATsession,
       anon_sym_ATmovement,
       anon_sym_ATtemplate,
       sym_date,
-  [459] = 1,
-    ACTIONS(143), 9,
+  [1548] = 8,
+    ACTIONS(335), 1,
+      anon_sym_LF,
+    ACTIONS(337), 1,
+      sym_comment,
+    ACTIONS(339), 1,
+      anon_sym_ATend,
+    ACTIONS(343), 1,
+      anon_sym_note_COLON,
+    ACTIONS(345), 1,
+      aux_sym_item_token1,
+    STATE(154), 1,
+      sym_item,
+    ACTIONS(341), 2,
+      anon_sym_ATsuperset,
+      anon_sym_ATcircuit,
+    STATE(73), 4,
+      sym_group_block,
+      sym_item_line,
+      sym_note_line,
+      aux_sym_session_block_repeat1,
+  [1577] = 1,
+    ACTIONS(347), 12,
       ts_builtin_sym_end,
       anon_sym_LF,
       anon_sym_ATinclude,
       anon_sym_ATplugin,
+      anon_sym_ATunits,
+      anon_sym_ATbodyweight,
+      anon_sym_ATgoal,
       sym_comment,
       anon_sym_ATsession,
       anon_sym_ATmovement,
       anon_sym_ATtemplate,
       sym_date,
-  [471] = 1,
-    ACTIONS(145), 9,
+  [1592] = 1,
+    ACTIONS(349), 12,
       ts_builtin_sym_end,
       anon_sym_LF,
       anon_sym_ATinclude,
       anon_sym_ATplugin,
+      anon_sym_ATunits,
+      anon_sym_ATbodyweight,
+      anon_sym_ATgoal,
       sym_comment,
       anon_sym_ATsession,
       anon_sym_ATmovement,
       anon_sym_ATtemplate,
       sym_date,
-  [483] = 2,
-    ACTIONS(149), 1,
-      anon_sym_LF,
-    ACTIONS(147), 8,
+  [1607] = 1,
+    ACTIONS(351), 12,
       ts_builtin_sym_end,
+      anon_sym_LF,
       anon_sym_ATinclude,
       anon_sym_ATplugin,
+      anon_sym_ATunits,
+      anon_sym_ATbodyweight,
+      anon_sym_ATgoal,
       sym_comment,
       anon_sym_ATsession,
       anon_sym_ATmovement,
       anon_sym_ATtemplate,
       sym_date,
-  [497] = 2,
-    ACTIONS(153), 1,
-      anon_sym_LF,
-    ACTIONS(151), 8,
+  [1622] = 1,
+    ACTIONS(353), 12,
       ts_builtin_sym_end,
+      anon_sym_LF,
       anon_sym_ATinclude,
       anon_sym_ATplugin,
+      anon_sym_ATunits,
+      anon_sym_ATbodyweight,
+      anon_sym_ATgoal,
       sym_comment,
       anon_sym_ATsession,
       anon_sym_ATmovement,
       anon_sym_ATtemplate,
       sym_date,
-  [511] = 1,
-    ACTIONS(155), 9,
+  [1637] = 1,
+    ACTIONS(355), 12,
       ts_builtin_sym_end,
       anon_sym_LF,
       anon_sym_ATinclude,
       anon_sym_ATplugin,
+      anon_sym_ATunits,
+      anon_sym_ATbodyweight,
+      anon_sym_ATgoal,
       sym_comment,
       anon_sym_ATsession,
       anon_sym_ATmovement,
       anon_sym_ATtemplate,
       sym_date,
-  [523] = 2,
-    ACTIONS(159), 1,
+  [1652] = 2,
+    ACTIONS(359), 1,
       anon_sym_LF,
-    ACTIONS(157), 8,
+    ACTIONS(357), 11,
       ts_builtin_sym_end,
       anon_sym_ATinclude,
       anon_sym_ATplugin,
+      anon_sym_ATunits,
+      anon_sym_ATbodyweight,
+      anon_sym_ATgoal,
       sym_comment,
       anon_sym_ATsession,
       anon_sym_ATmovement,
       anon_sym_ATtemplate,
       sym_date,
-  [537] = 2,
-    ACTIONS(163), 1,
+  [1669] = 2,
+    ACTIONS(363), 1,
       anon_sym_LF,
-    ACTIONS(161), 8,
+    ACTIONS(361), 11,
       ts_builtin_sym_end,
       anon_sym_ATinclude,
       anon_sym_ATplugin,
+      anon_sym_ATunits,
+      anon_sym_ATbodyweight,
+      anon_sym_ATgoal,
       sym_comment,
       anon_sym_ATsession,
       anon_sym_ATmovement,
       anon_sym_ATtemplate,
       sym_date,
-  [551] = 2,
-    ACTIONS(167), 1,
+  [1686] = 8,
+    ACTIONS(337), 1,
+      sym_comment,
+    ACTIONS(343), 1,
+      anon_sym_note_COLON,
+    ACTIONS(345), 1,
+      aux_sym_item_token1,
+    ACTIONS(365), 1,
       anon_sym_LF,
-    ACTIONS(165), 8,
+    ACTIONS(367), 1,
+      anon_sym_ATend,
+    STATE(154), 1,
+      sym_item,
+    ACTIONS(341), 2,
+      anon_sym_ATsuperset,
+      anon_sym_ATcircuit,
+    STATE(77), 4,
+      sym_group_block,
+      sym_item_line,
+      sym_note_line,
+      aux_sym_session_block_repeat1,
+  [1715] = 1,
+    ACTIONS(369), 12,
       ts_builtin_sym_end,
+      anon_sym_LF,
       anon_sym_ATinclude,
       anon_sym_ATplugin,
+      anon_sym_ATunits,
+      anon_sym_ATbodyweight,
+      anon_sym_ATgoal,
       sym_comment,
       anon_sym_ATsession,
       anon_sym_ATmovement,
       anon_sym_ATtemplate,
       sym_date,
-  [565] = 1,
-    ACTIONS(169), 9,
+  [1730] = 1,
+    ACTIONS(371), 12,
       ts_builtin_sym_end,
       anon_sym_LF,
       anon_sym_ATinclude,
       anon_sym_ATplugin,
+      anon_sym_ATunits,
+      anon_sym_ATbodyweight,
+      anon_sym_ATgoal,
       sym_comment,
       anon_sym_ATsession,
       anon_sym_ATmovement,
       anon_sym_ATtemplate,
       sym_date,
-  [577] = 1,
-    ACTIONS(171), 9,
-      ts_builtin_sym_end,
+  [1745] = 2,
+    ACTIONS(373), 1,
       anon_sym_LF,
+    ACTIONS(371), 11,
+      ts_builtin_sym_end,
       anon_sym_ATinclude,
       anon_sym_ATplugin,
+      anon_sym_ATunits,
+      anon_sym_ATbodyweight,
+      anon_sym_ATgoal,
       sym_comment,
       anon_sym_ATsession,
       anon_sym_ATmovement,
       anon_sym_ATtemplate,
       sym_date,
-  [589] = 1,
-    ACTIONS(173), 9,
+  [1762] = 8,
+    ACTIONS(375), 1,
+      anon_sym_LF,
+    ACTIONS(378), 1,
+      sym_comment,
+    ACTIONS(381), 1,
+      anon_sym_ATend,
+    ACTIONS(386), 1,
+      anon_sym_note_COLON,
+    ACTIONS(389), 1,
+      aux_sym_item_token1,
+    STATE(154), 1,
+      sym_item,
+    ACTIONS(383), 2,
+      anon_sym_ATsuperset,
+      anon_sym_ATcircuit,
+    STATE(77), 4,
+      sym_group_block,
+      sym_item_line,
+      sym_note_line,
+      aux_sym_session_block_repeat1,
+  [1791] = 1,
+    ACTIONS(392), 12,
       ts_builtin_sym_end,
       anon_sym_LF,
       anon_sym_ATinclude,
       anon_sym_ATplugin,
+      anon_sym_ATunits,
+      anon_sym_ATbodyweight,
+      anon_sym_ATgoal,
       sym_comment,
       anon_sym_ATsession,
       anon_sym_ATmovement,
       anon_sym_ATtemplate,
       sym_date,
-  [601] = 1,
-    ACTIONS(175), 9,
+  [1806] = 1,
+    ACTIONS(394), 12,
       ts_builtin_sym_end,
       anon_sym_LF,
       anon_sym_ATinclude,
       anon_sym_ATplugin,
+      anon_sym_ATunits,
+      anon_sym_ATbodyweight,
+      anon_sym_ATgoal,
       sym_comment,
       anon_sym_ATsession,
       anon_sym_ATmovement,
       anon_sym_ATtemplate,
       sym_date,
-  [613] = 1,
-    ACTIONS(177), 9,
+  [1821] = 1,
+    ACTIONS(396), 12,
       ts_builtin_sym_end,
       anon_sym_LF,
       anon_sym_ATinclude,
       anon_sym_ATplugin,
+      anon_sym_ATunits,
+      anon_sym_ATbodyweight,
+      anon_sym_ATgoal,
       sym_comment,
       anon_sym_ATsession,
       anon_sym_ATmovement,
       anon_sym_ATtemplate,
       sym_date,
-  [625] = 2,
-    ACTIONS(181), 1,
-      anon_sym_LF,
-    ACTIONS(179), 8,
+  [1836] = 1,
+    ACTIONS(398), 12,
       ts_builtin_sym_end,
+      anon_sym_LF,
       anon_sym_ATinclude,
       anon_sym_ATplugin,
+      anon_sym_ATunits,
+      anon_sym_ATbodyweight,
+      anon_sym_ATgoal,
       sym_comment,
       anon_sym_ATsession,
       anon_sym_ATmovement,
       anon_sym_ATtemplate,
       sym_date,
-  [639] = 2,
-    ACTIONS(185), 1,
-      anon_sym_LF,
-    ACTIONS(183), 8,
+  [1851] = 1,
+    ACTIONS(400), 12,
       ts_builtin_sym_end,
+      anon_sym_LF,
       anon_sym_ATinclude,
       anon_sym_ATplugin,
+      anon_sym_ATunits,
+      anon_sym_ATbodyweight,
+      anon_sym_ATgoal,
       sym_comment,
       anon_sym_ATsession,
       anon_sym_ATmovement,
       anon_sym_ATtemplate,
       sym_date,
-  [653] = 1,
-    ACTIONS(187), 9,
-      ts_builtin_sym_end,
+  [1866] = 2,
+    ACTIONS(404), 1,
       anon_sym_LF,
+    ACTIONS(402), 11,
+      ts_builtin_sym_end,
       anon_sym_ATinclude,
       anon_sym_ATplugin,
+      anon_sym_ATunits,
+      anon_sym_ATbodyweight,
+      anon_sym_ATgoal,
       sym_comment,
       anon_sym_ATsession,
       anon_sym_ATmovement,
       anon_sym_ATtemplate,
       sym_date,
-  [665] = 2,
-    ACTIONS(189), 1,
+  [1883] = 2,
+    ACTIONS(408), 1,
       anon_sym_LF,
-    ACTIONS(187), 8,
+    ACTIONS(406), 11,
       ts_builtin_sym_end,
       anon_sym_ATinclude,
       anon_sym_ATplugin,
+      anon_sym_ATunits,
+      anon_sym_ATbodyweight,
+      anon_sym_ATgoal,
       sym_comment,
       anon_sym_ATsession,
       anon_sym_ATmovement,
       anon_sym_ATtemplate,
       sym_date,
-  [679] = 1,
-    ACTIONS(191), 9,
-      ts_builtin_sym_end,
+  [1900] = 2,
+    ACTIONS(412), 1,
       anon_sym_LF,
+    ACTIONS(410), 11,
+      ts_builtin_sym_end,
       anon_sym_ATinclude,
       anon_sym_ATplugin,
+      anon_sym_ATunits,
+      anon_sym_ATbodyweight,
+      anon_sym_ATgoal,
       sym_comment,
       anon_sym_ATsession,
       anon_sym_ATmovement,
       anon_sym_ATtemplate,
       sym_date,
-  [691] = 5,
-    ACTIONS(193), 1,
-      anon_sym_ATend,
-    ACTIONS(195), 1,
+  [1917] = 2,
+    ACTIONS(414), 3,
+      anon_sym_LF,
+      sym_comment,
       anon_sym_note_COLON,
-    ACTIONS(197), 1,
+    ACTIONS(416), 4,
+      anon_sym_ATend,
+      anon_sym_ATsuperset,
+      anon_sym_ATcircuit,
       aux_sym_item_token1,
-    STATE(87), 1,
-      sym_item,
-    STATE(49), 3,
-      sym_item_line,
-      sym_note_line,
-      aux_sym_session_block_repeat1,
-  [709] = 5,
-    ACTIONS(195), 1,
+  [1929] = 2,
+    ACTIONS(418), 3,
+      anon_sym_LF,
+      sym_comment,
       anon_sym_note_COLON,
-    ACTIONS(197), 1,
+    ACTIONS(420), 4,
+      anon_sym_ATend,
+      anon_sym_ATsuperset,
+      anon_sym_ATcircuit,
       aux_sym_item_token1,
-    ACTIONS(199), 1,
+  [1941] = 6,
+    ACTIONS(422), 1,
+      anon_sym_note,
+    ACTIONS(424), 1,
+      anon_sym_W,
+    ACTIONS(426), 1,
+      anon_sym_rest,
+    ACTIONS(428), 1,
+      anon_sym_query,
+    STATE(110), 1,
+      sym_flag,
+    ACTIONS(430), 2,
+      anon_sym_STAR,
+      anon_sym_BANG,
+  [1961] = 5,
+    ACTIONS(345), 1,
+      aux_sym_item_token1,
+    ACTIONS(432), 1,
       anon_sym_ATend,
-    STATE(87), 1,
+    ACTIONS(434), 1,
+      anon_sym_note_COLON,
+    STATE(140), 1,
       sym_item,
-    STATE(49), 3,
+    STATE(90), 3,
       sym_item_line,
       sym_note_line,
-      aux_sym_session_block_repeat1,
-  [727] = 5,
-    ACTIONS(195), 1,
+      aux_sym_template_block_repeat1,
+  [1979] = 5,
+    ACTIONS(436), 1,
+      anon_sym_ATend,
+    ACTIONS(438), 1,
       anon_sym_note_COLON,
-    ACTIONS(197), 1,
+    ACTIONS(441), 1,
       aux_sym_item_token1,
-    ACTIONS(201), 1,
-      anon_sym_ATend,
-    STATE(87), 1,
+    STATE(140), 1,
       sym_item,
-    STATE(45), 3,
+    STATE(90), 3,
       sym_item_line,
       sym_note_line,
-      aux_sym_session_block_repeat1,
-  [745] = 5,
-    ACTIONS(195), 1,
+      aux_sym_template_block_repeat1,
+  [1997] = 2,
+    ACTIONS(444), 3,
+      anon_sym_LF,
+      sym_comment,
       anon_sym_note_COLON,
-    ACTIONS(197), 1,
-      aux_sym_item_token1,
-    ACTIONS(203), 1,
+    ACTIONS(381), 4,
       anon_sym_ATend,
-    STATE(87), 1,
-      sym_item,
-    STATE(44), 3,
-      sym_item_line,
-      sym_note_line,
-      aux_sym_session_block_repeat1,
-  [763] = 7,
-    ACTIONS(50), 1,
-      sym_weight,
-    ACTIONS(52), 1,
-      sym_rep_scheme,
-    ACTIONS(54), 1,
-      sym_duration,
-    ACTIONS(56), 1,
-      sym_distance,
-    ACTIONS(58), 1,
-      sym_quoted_string,
-    STATE(6), 1,
-      aux_sym_details_repeat1,
-    STATE(83), 1,
-      sym_details,
-  [785] = 5,
-    ACTIONS(205), 1,
+      anon_sym_ATsuperset,
+      anon_sym_ATcircuit,
+      aux_sym_item_token1,
+  [2009] = 2,
+    ACTIONS(446), 3,
+      anon_sym_LF,
+      sym_comment,
+      anon_sym_note_COLON,
+    ACTIONS(448), 4,
       anon_sym_ATend,
-    ACTIONS(207), 1,
+      anon_sym_ATsuperset,
+      anon_sym_ATcircuit,
+      aux_sym_item_token1,
+  [2021] = 2,
+    ACTIONS(450), 3,
+      anon_sym_LF,
+      sym_comment,
       anon_sym_note_COLON,
-    ACTIONS(210), 1,
+    ACTIONS(452), 4,
+      anon_sym_ATend,
+      anon_sym_ATsuperset,
+      anon_sym_ATcircuit,
       aux_sym_item_token1,
-    STATE(87), 1,
+  [2033] = 5,
+    ACTIONS(345), 1,
+      aux_sym_item_token1,
+    ACTIONS(434), 1,
+      anon_sym_note_COLON,
+    ACTIONS(454), 1,
+      anon_sym_ATend,
+    STATE(140), 1,
       sym_item,
-    STATE(49), 3,
+    STATE(89), 3,
       sym_item_line,
       sym_note_line,
-      aux_sym_session_block_repeat1,
-  [803] = 5,
-    ACTIONS(213), 1,
-      anon_sym_note,
-    ACTIONS(215), 1,
-      anon_sym_W,
-    ACTIONS(217), 1,
-      anon_sym_query,
-    STATE(66), 1,
-      sym_flag,
-    ACTIONS(219), 2,
-      anon_sym_STAR,
-      anon_sym_BANG,
-  [820] = 4,
-    ACTIONS(221), 1,
+      aux_sym_template_block_repeat1,
+  [2051] = 4,
+    ACTIONS(456), 1,
       anon_sym_ATend,
-    ACTIONS(223), 1,
+    ACTIONS(458), 1,
       aux_sym_item_token1,
-    STATE(81), 1,
+    STATE(144), 1,
       sym_identifier,
-    STATE(51), 2,
+    STATE(97), 2,
       sym_metadata_line,
       aux_sym_movement_block_repeat1,
-  [834] = 4,
-    ACTIONS(226), 1,
+  [2065] = 4,
+    ACTIONS(345), 1,
+      aux_sym_item_token1,
+    ACTIONS(460), 1,
       anon_sym_ATend,
-    ACTIONS(228), 1,
+    STATE(155), 1,
+      sym_item,
+    STATE(98), 2,
+      sym_item_line,
+      aux_sym_group_block_repeat1,
+  [2079] = 4,
+    ACTIONS(458), 1,
       aux_sym_item_token1,
-    STATE(81), 1,
+    ACTIONS(462), 1,
+      anon_sym_ATend,
+    STATE(144), 1,
       sym_identifier,
-    STATE(53), 2,
+    STATE(99), 2,
       sym_metadata_line,
       aux_sym_movement_block_repeat1,
-  [848] = 4,
-    ACTIONS(228), 1,
+  [2093] = 4,
+    ACTIONS(464), 1,
+      anon_sym_ATend,
+    ACTIONS(466), 1,
       aux_sym_item_token1,
-    ACTIONS(230), 1,
+    STATE(155), 1,
+      sym_item,
+    STATE(98), 2,
+      sym_item_line,
+      aux_sym_group_block_repeat1,
+  [2107] = 4,
+    ACTIONS(469), 1,
       anon_sym_ATend,
-    STATE(81), 1,
+    ACTIONS(471), 1,
+      aux_sym_item_token1,
+    STATE(144), 1,
       sym_identifier,
-    STATE(51), 2,
+    STATE(99), 2,
       sym_metadata_line,
       aux_sym_movement_block_repeat1,
-  [862] = 3,
-    ACTIONS(232), 1,
+  [2121] = 3,
+    ACTIONS(474), 1,
+      aux_sym_item_token1,
+    STATE(155), 1,
+      sym_item,
+    STATE(96), 2,
+      sym_item_line,
+      aux_sym_group_block_repeat1,
+  [2132] = 3,
+    ACTIONS(476), 1,
       anon_sym_LF,
-    ACTIONS(234), 1,
+    ACTIONS(478), 1,
       aux_sym_name_token1,
-    STATE(79), 1,
+    STATE(134), 1,
       sym_text_until_newline,
-  [872] = 2,
-    ACTIONS(238), 1,
+  [2142] = 2,
+    ACTIONS(418), 1,
       anon_sym_note_COLON,
-    ACTIONS(236), 2,
+    ACTIONS(420), 2,
       anon_sym_ATend,
       aux_sym_item_token1,
-  [880] = 2,
-    STATE(63), 1,
+  [2150] = 2,
+    ACTIONS(414), 1,
+      anon_sym_note_COLON,
+    ACTIONS(416), 2,
+      anon_sym_ATend,
+      aux_sym_item_token1,
+  [2158] = 2,
+    STATE(116), 1,
       sym_flag,
-    ACTIONS(240), 2,
+    ACTIONS(480), 2,
       anon_sym_STAR,
       anon_sym_BANG,
-  [888] = 2,
-    ACTIONS(244), 1,
+  [2166] = 2,
+    ACTIONS(450), 1,
       anon_sym_note_COLON,
-    ACTIONS(242), 2,
+    ACTIONS(452), 2,
+      anon_sym_ATend,
+      aux_sym_item_token1,
+  [2174] = 2,
+    ACTIONS(482), 1,
+      aux_sym_item_token1,
+    STATE(147), 1,
+      sym_identifier,
+  [2181] = 1,
+    ACTIONS(484), 2,
+      anon_sym_ATend,
+      aux_sym_item_token1,
+  [2186] = 2,
+    ACTIONS(486), 1,
+      anon_sym_LF,
+    ACTIONS(488), 1,
+      sym_comment,
+  [2193] = 1,
+    ACTIONS(490), 2,
       anon_sym_ATend,
       aux_sym_item_token1,
-  [896] = 2,
-    ACTIONS(246), 1,
+  [2198] = 2,
+    ACTIONS(474), 1,
+      aux_sym_item_token1,
+    STATE(141), 1,
+      sym_item,
+  [2205] = 2,
+    ACTIONS(492), 1,
       anon_sym_DQUOTE,
-    STATE(29), 1,
+    STATE(61), 1,
       sym_file_path,
-  [903] = 2,
-    ACTIONS(248), 1,
+  [2212] = 2,
+    ACTIONS(482), 1,
       aux_sym_item_token1,
-    STATE(67), 1,
+    STATE(62), 1,
       sym_identifier,
-  [910] = 2,
-    ACTIONS(250), 1,
-      aux_sym_name_token1,
-    STATE(71), 1,
-      sym_name,
-  [917] = 2,
-    ACTIONS(246), 1,
+  [2219] = 2,
+    ACTIONS(494), 1,
+      aux_sym_item_token1,
+    STATE(137), 1,
+      sym_identifier,
+  [2226] = 2,
+    ACTIONS(492), 1,
       anon_sym_DQUOTE,
     STATE(40), 1,
       sym_file_path,
-  [924] = 1,
-    ACTIONS(252), 2,
-      anon_sym_ATend,
-      aux_sym_item_token1,
-  [929] = 2,
-    ACTIONS(250), 1,
+  [2233] = 2,
+    ACTIONS(496), 1,
+      aux_sym_name_token1,
+    STATE(139), 1,
+      sym_name,
+  [2240] = 2,
+    ACTIONS(496), 1,
       aux_sym_name_token1,
-    STATE(88), 1,
+    STATE(153), 1,
       sym_name,
-  [936] = 1,
-    ACTIONS(254), 2,
+  [2247] = 1,
+    ACTIONS(416), 2,
       anon_sym_ATend,
       aux_sym_item_token1,
-  [941] = 1,
-    ACTIONS(256), 2,
-      anon_sym_LF,
-      anon_sym_COLON,
-  [946] = 2,
-    ACTIONS(258), 1,
+  [2252] = 1,
+    ACTIONS(420), 2,
+      anon_sym_ATend,
       aux_sym_item_token1,
-    STATE(76), 1,
-      sym_item,
-  [953] = 1,
-    ACTIONS(260), 1,
+  [2257] = 2,
+    ACTIONS(498), 1,
+      anon_sym_LF,
+    ACTIONS(500), 1,
+      sym_comment,
+  [2264] = 2,
+    ACTIONS(502), 1,
       anon_sym_LF,
-  [957] = 1,
-    ACTIONS(262), 1,
+    ACTIONS(504), 1,
+      sym_comment,
+  [2271] = 1,
+    ACTIONS(253), 1,
       sym_weight,
-  [961] = 1,
-    ACTIONS(264), 1,
+  [2275] = 1,
+    ACTIONS(506), 1,
       anon_sym_LF,
-  [965] = 1,
-    ACTIONS(266), 1,
+  [2279] = 1,
+    ACTIONS(508), 1,
       sym_quoted_string,
-  [969] = 1,
-    ACTIONS(268), 1,
+  [2283] = 1,
+    ACTIONS(510), 1,
       anon_sym_LF,
-  [973] = 1,
-    ACTIONS(270), 1,
-      ts_builtin_sym_end,
-  [977] = 1,
-    ACTIONS(272), 1,
-      anon_sym_DQUOTE,
-  [981] = 1,
-    ACTIONS(274), 1,
+  [2287] = 1,
+    ACTIONS(512), 1,
       sym_quoted_string,
-  [985] = 1,
-    ACTIONS(276), 1,
-      anon_sym_COLON,
-  [989] = 1,
-    ACTIONS(278), 1,
-      anon_sym_COLON,
-  [993] = 1,
-    ACTIONS(280), 1,
+  [2291] = 1,
+    ACTIONS(514), 1,
+      aux_sym_name_token1,
+  [2295] = 1,
+    ACTIONS(516), 1,
+      sym_weight,
+  [2299] = 1,
+    ACTIONS(518), 1,
+      sym_weight,
+  [2303] = 1,
+    ACTIONS(520), 1,
+      sym_quoted_string,
+  [2307] = 1,
+    ACTIONS(514), 1,
       aux_sym_item_token1,
-  [997] = 1,
-    ACTIONS(282), 1,
+  [2311] = 1,
+    ACTIONS(522), 1,
+      anon_sym_COLON,
+  [2315] = 1,
+    ACTIONS(524), 1,
+      anon_sym_LF,
+  [2319] = 1,
+    ACTIONS(526), 1,
+      anon_sym_DQUOTE,
+  [2323] = 1,
+    ACTIONS(528), 1,
       anon_sym_LF,
-  [1001] = 1,
-    ACTIONS(284), 1,
+  [2327] = 1,
+    ACTIONS(530), 1,
+      aux_sym_file_path_token1,
+  [2331] = 1,
+    ACTIONS(532), 1,
+      anon_sym_LF,
+  [2335] = 1,
+    ACTIONS(534), 1,
+      sym_weight,
+  [2339] = 1,
+    ACTIONS(536), 1,
+      anon_sym_LF,
+  [2343] = 1,
+    ACTIONS(538), 1,
       anon_sym_LF,
-  [1005] = 1,
-    ACTIONS(286), 1,
+  [2347] = 1,
+    ACTIONS(540), 1,
+      anon_sym_COLON,
+  [2351] = 1,
+    ACTIONS(542), 1,
+      anon_sym_COLON,
+  [2355] = 1,
+    ACTIONS(544), 1,
       anon_sym_LF,
-  [1009] = 1,
-    ACTIONS(288), 1,
+  [2359] = 1,
+    ACTIONS(546), 1,
+      anon_sym_LF,
+  [2363] = 1,
+    ACTIONS(548), 1,
       anon_sym_COLON,
-  [1013] = 1,
-    ACTIONS(290), 1,
+  [2367] = 1,
+    ACTIONS(550), 1,
       sym_quoted_string,
-  [1017] = 1,
-    ACTIONS(292), 1,
+  [2371] = 1,
+    ACTIONS(552), 1,
+      anon_sym_LF,
+  [2375] = 1,
+    ACTIONS(554), 1,
       anon_sym_LF,
-  [1021] = 1,
-    ACTIONS(294), 1,
+  [2379] = 1,
+    ACTIONS(556), 1,
+      anon_sym_LF,
+  [2383] = 1,
+    ACTIONS(558), 1,
+      anon_sym_LF,
+  [2387] = 1,
+    ACTIONS(560), 1,
+      anon_sym_LF,
+  [2391] = 1,
+    ACTIONS(562), 1,
       sym_quoted_string,
-  [1025] = 1,
-    ACTIONS(296), 1,
-      aux_sym_file_path_token1,
-  [1029] = 1,
-    ACTIONS(298), 1,
+  [2395] = 1,
+    ACTIONS(564), 1,
+      ts_builtin_sym_end,
+  [2399] = 1,
+    ACTIONS(566), 1,
       anon_sym_LF,
-  [1033] = 1,
-    ACTIONS(300), 1,
+  [2403] = 1,
+    ACTIONS(568), 1,
       anon_sym_COLON,
-  [1037] = 1,
-    ACTIONS(302), 1,
-      anon_sym_LF,
-  [1041] = 1,
-    ACTIONS(304), 1,
+  [2407] = 1,
+    ACTIONS(570), 1,
+      anon_sym_COLON,
+  [2411] = 1,
+    ACTIONS(572), 1,
       sym_date,
-  [1045] = 1,
-    ACTIONS(280), 1,
-      aux_sym_name_token1,
 };
 
 static const uint32_t ts_small_parse_table_map[] = {
   [SMALL_STATE(2)] = 0,
-  [SMALL_STATE(3)] = 39,
-  [SMALL_STATE(4)] = 78,
-  [SMALL_STATE(5)] = 113,
-  [SMALL_STATE(6)] = 143,
-  [SMALL_STATE(7)] = 173,
-  [SMALL_STATE(8)] = 192,
-  [SMALL_STATE(9)] = 211,
-  [SMALL_STATE(10)] = 230,
-  [SMALL_STATE(11)] = 249,
-  [SMALL_STATE(12)] = 268,
-  [SMALL_STATE(13)] = 288,
-  [SMALL_STATE(14)] = 305,
-  [SMALL_STATE(15)] = 317,
-  [SMALL_STATE(16)] = 331,
-  [SMALL_STATE(17)] = 345,
-  [SMALL_STATE(18)] = 357,
-  [SMALL_STATE(19)] = 369,
-  [SMALL_STATE(20)] = 383,
-  [SMALL_STATE(21)] = 395,
-  [SMALL_STATE(22)] = 409,
-  [SMALL_STATE(23)] = 421,
-  [SMALL_STATE(24)] = 435,
-  [SMALL_STATE(25)] = 447,
-  [SMALL_STATE(26)] = 459,
-  [SMALL_STATE(27)] = 471,
-  [SMALL_STATE(28)] = 483,
-  [SMALL_STATE(29)] = 497,
-  [SMALL_STATE(30)] = 511,
-  [SMALL_STATE(31)] = 523,
-  [SMALL_STATE(32)] = 537,
-  [SMALL_STATE(33)] = 551,
-  [SMALL_STATE(34)] = 565,
-  [SMALL_STATE(35)] = 577,
-  [SMALL_STATE(36)] = 589,
-  [SMALL_STATE(37)] = 601,
-  [SMALL_STATE(38)] = 613,
-  [SMALL_STATE(39)] = 625,
-  [SMALL_STATE(40)] = 639,
-  [SMALL_STATE(41)] = 653,
-  [SMALL_STATE(42)] = 665,
-  [SMALL_STATE(43)] = 679,
-  [SMALL_STATE(44)] = 691,
-  [SMALL_STATE(45)] = 709,
-  [SMALL_STATE(46)] = 727,
-  [SMALL_STATE(47)] = 745,
-  [SMALL_STATE(48)] = 763,
-  [SMALL_STATE(49)] = 785,
-  [SMALL_STATE(50)] = 803,
-  [SMALL_STATE(51)] = 820,
-  [SMALL_STATE(52)] = 834,
-  [SMALL_STATE(53)] = 848,
-  [SMALL_STATE(54)] = 862,
-  [SMALL_STATE(55)] = 872,
-  [SMALL_STATE(56)] = 880,
-  [SMALL_STATE(57)] = 888,
-  [SMALL_STATE(58)] = 896,
-  [SMALL_STATE(59)] = 903,
-  [SMALL_STATE(60)] = 910,
-  [SMALL_STATE(61)] = 917,
-  [SMALL_STATE(62)] = 924,
-  [SMALL_STATE(63)] = 929,
-  [SMALL_STATE(64)] = 936,
-  [SMALL_STATE(65)] = 941,
-  [SMALL_STATE(66)] = 946,
-  [SMALL_STATE(67)] = 953,
-  [SMALL_STATE(68)] = 957,
-  [SMALL_STATE(69)] = 961,
-  [SMALL_STATE(70)] = 965,
-  [SMALL_STATE(71)] = 969,
-  [SMALL_STATE(72)] = 973,
-  [SMALL_STATE(73)] = 977,
-  [SMALL_STATE(74)] = 981,
-  [SMALL_STATE(75)] = 985,
-  [SMALL_STATE(76)] = 989,
-  [SMALL_STATE(77)] = 993,
-  [SMALL_STATE(78)] = 997,
-  [SMALL_STATE(79)] = 1001,
-  [SMALL_STATE(80)] = 1005,
-  [SMALL_STATE(81)] = 1009,
-  [SMALL_STATE(82)] = 1013,
-  [SMALL_STATE(83)] = 1017,
-  [SMALL_STATE(84)] = 1021,
-  [SMALL_STATE(85)] = 1025,
-  [SMALL_STATE(86)] = 1029,
-  [SMALL_STATE(87)] = 1033,
-  [SMALL_STATE(88)] = 1037,
-  [SMALL_STATE(89)] = 1041,
-  [SMALL_STATE(90)] = 1045,
+  [SMALL_STATE(3)] = 52,
+  [SMALL_STATE(4)] = 104,
+  [SMALL_STATE(5)] = 165,
+  [SMALL_STATE(6)] = 219,
+  [SMALL_STATE(7)] = 273,
+  [SMALL_STATE(8)] = 302,
+  [SMALL_STATE(9)] = 331,
+  [SMALL_STATE(10)] = 360,
+  [SMALL_STATE(11)] = 389,
+  [SMALL_STATE(12)] = 418,
+  [SMALL_STATE(13)] = 447,
+  [SMALL_STATE(14)] = 476,
+  [SMALL_STATE(15)] = 505,
+  [SMALL_STATE(16)] = 534,
+  [SMALL_STATE(17)] = 563,
+  [SMALL_STATE(18)] = 592,
+  [SMALL_STATE(19)] = 621,
+  [SMALL_STATE(20)] = 665,
+  [SMALL_STATE(21)] = 709,
+  [SMALL_STATE(22)] = 752,
+  [SMALL_STATE(23)] = 775,
+  [SMALL_STATE(24)] = 794,
+  [SMALL_STATE(25)] = 813,
+  [SMALL_STATE(26)] = 832,
+  [SMALL_STATE(27)] = 851,
+  [SMALL_STATE(28)] = 870,
+  [SMALL_STATE(29)] = 889,
+  [SMALL_STATE(30)] = 908,
+  [SMALL_STATE(31)] = 927,
+  [SMALL_STATE(32)] = 946,
+  [SMALL_STATE(33)] = 965,
+  [SMALL_STATE(34)] = 984,
+  [SMALL_STATE(35)] = 1003,
+  [SMALL_STATE(36)] = 1046,
+  [SMALL_STATE(37)] = 1089,
+  [SMALL_STATE(38)] = 1109,
+  [SMALL_STATE(39)] = 1125,
+  [SMALL_STATE(40)] = 1145,
+  [SMALL_STATE(41)] = 1162,
+  [SMALL_STATE(42)] = 1179,
+  [SMALL_STATE(43)] = 1194,
+  [SMALL_STATE(44)] = 1209,
+  [SMALL_STATE(45)] = 1226,
+  [SMALL_STATE(46)] = 1243,
+  [SMALL_STATE(47)] = 1258,
+  [SMALL_STATE(48)] = 1273,
+  [SMALL_STATE(49)] = 1290,
+  [SMALL_STATE(50)] = 1305,
+  [SMALL_STATE(51)] = 1322,
+  [SMALL_STATE(52)] = 1337,
+  [SMALL_STATE(53)] = 1354,
+  [SMALL_STATE(54)] = 1369,
+  [SMALL_STATE(55)] = 1386,
+  [SMALL_STATE(56)] = 1401,
+  [SMALL_STATE(57)] = 1418,
+  [SMALL_STATE(58)] = 1433,
+  [SMALL_STATE(59)] = 1448,
+  [SMALL_STATE(60)] = 1463,
+  [SMALL_STATE(61)] = 1478,
+  [SMALL_STATE(62)] = 1495,
+  [SMALL_STATE(63)] = 1512,
+  [SMALL_STATE(64)] = 1529,
+  [SMALL_STATE(65)] = 1548,
+  [SMALL_STATE(66)] = 1577,
+  [SMALL_STATE(67)] = 1592,
+  [SMALL_STATE(68)] = 1607,
+  [SMALL_STATE(69)] = 1622,
+  [SMALL_STATE(70)] = 1637,
+  [SMALL_STATE(71)] = 1652,
+  [SMALL_STATE(72)] = 1669,
+  [SMALL_STATE(73)] = 1686,
+  [SMALL_STATE(74)] = 1715,
+  [SMALL_STATE(75)] = 1730,
+  [SMALL_STATE(76)] = 1745,
+  [SMALL_STATE(77)] = 1762,
+  [SMALL_STATE(78)] = 1791,
+  [SMALL_STATE(79)] = 1806,
+  [SMALL_STATE(80)] = 1821,
+  [SMALL_STATE(81)] = 1836,
+  [SMALL_STATE(82)] = 1851,
+  [SMALL_STATE(83)] = 1866,
+  [SMALL_STATE(84)] = 1883,
+  [SMALL_STATE(85)] = 1900,
+  [SMALL_STATE(86)] = 1917,
+  [SMALL_STATE(87)] = 1929,
+  [SMALL_STATE(88)] = 1941,
+  [SMALL_STATE(89)] = 1961,
+  [SMALL_STATE(90)] = 1979,
+  [SMALL_STATE(91)] = 1997,
+  [SMALL_STATE(92)] = 2009,
+  [SMALL_STATE(93)] = 2021,
+  [SMALL_STATE(94)] = 2033,
+  [SMALL_STATE(95)] = 2051,
+  [SMALL_STATE(96)] = 2065,
+  [SMALL_STATE(97)] = 2079,
+  [SMALL_STATE(98)] = 2093,
+  [SMALL_STATE(99)] = 2107,
+  [SMALL_STATE(100)] = 2121,
+  [SMALL_STATE(101)] = 2132,
+  [SMALL_STATE(102)] = 2142,
+  [SMALL_STATE(103)] = 2150,
+  [SMALL_STATE(104)] = 2158,
+  [SMALL_STATE(105)] = 2166,
+  [SMALL_STATE(106)] = 2174,
+  [SMALL_STATE(107)] = 2181,
+  [SMALL_STATE(108)] = 2186,
+  [SMALL_STATE(109)] = 2193,
+  [SMALL_STATE(110)] = 2198,
+  [SMALL_STATE(111)] = 2205,
+  [SMALL_STATE(112)] = 2212,
+  [SMALL_STATE(113)] = 2219,
+  [SMALL_STATE(114)] = 2226,
+  [SMALL_STATE(115)] = 2233,
+  [SMALL_STATE(116)] = 2240,
+  [SMALL_STATE(117)] = 2247,
+  [SMALL_STATE(118)] = 2252,
+  [SMALL_STATE(119)] = 2257,
+  [SMALL_STATE(120)] = 2264,
+  [SMALL_STATE(121)] = 2271,
+  [SMALL_STATE(122)] = 2275,
+  [SMALL_STATE(123)] = 2279,
+  [SMALL_STATE(124)] = 2283,
+  [SMALL_STATE(125)] = 2287,
+  [SMALL_STATE(126)] = 2291,
+  [SMALL_STATE(127)] = 2295,
+  [SMALL_STATE(128)] = 2299,
+  [SMALL_STATE(129)] = 2303,
+  [SMALL_STATE(130)] = 2307,
+  [SMALL_STATE(131)] = 2311,
+  [SMALL_STATE(132)] = 2315,
+  [SMALL_STATE(133)] = 2319,
+  [SMALL_STATE(134)] = 2323,
+  [SMALL_STATE(135)] = 2327,
+  [SMALL_STATE(136)] = 2331,
+  [SMALL_STATE(137)] = 2335,
+  [SMALL_STATE(138)] = 2339,
+  [SMALL_STATE(139)] = 2343,
+  [SMALL_STATE(140)] = 2347,
+  [SMALL_STATE(141)] = 2351,
+  [SMALL_STATE(142)] = 2355,
+  [SMALL_STATE(143)] = 2359,
+  [SMALL_STATE(144)] = 2363,
+  [SMALL_STATE(145)] = 2367,
+  [SMALL_STATE(146)] = 2371,
+  [SMALL_STATE(147)] = 2375,
+  [SMALL_STATE(148)] = 2379,
+  [SMALL_STATE(149)] = 2383,
+  [SMALL_STATE(150)] = 2387,
+  [SMALL_STATE(151)] = 2391,
+  [SMALL_STATE(152)] = 2395,
+  [SMALL_STATE(153)] = 2399,
+  [SMALL_STATE(154)] = 2403,
+  [SMALL_STATE(155)] = 2407,
+  [SMALL_STATE(156)] = 2411,
 };
 
 static const TSParseActionEntry ts_parse_actions[] = {
   [0] = {.entry = {.count = 0, .reusable = false}},
   [1] = {.entry = {.count = 1, .reusable = false}}, RECOVER(),
   [3] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_source_file, 0, 0, 0),
-  [5] = {.entry = {.count = 1, .reusable = true}}, SHIFT(3),
-  [7] = {.entry = {.count = 1, .reusable = true}}, SHIFT(61),
-  [9] = {.entry = {.count = 1, .reusable = true}}, SHIFT(58),
-  [11] = {.entry = {.count = 1, .reusable = true}}, SHIFT(86),
-  [13] = {.entry = {.count = 1, .reusable = true}}, SHIFT(59),
-  [15] = {.entry = {.count = 1, .reusable = true}}, SHIFT(60),
-  [17] = {.entry = {.count = 1, .reusable = true}}, SHIFT(50),
-  [19] = {.entry = {.count = 1, .reusable = true}}, REDUCE(aux_sym_source_file_repeat1, 2, 0, 0),
-  [21] = {.entry = {.count = 2, .reusable = true}}, REDUCE(aux_sym_source_file_repeat1, 2, 0, 0), SHIFT_REPEAT(2),
-  [24] = {.entry = {.count = 2, .reusable = true}}, REDUCE(aux_sym_source_file_repeat1, 2, 0, 0), SHIFT_REPEAT(61),
-  [27] = {.entry = {.count = 2, .reusable = true}}, REDUCE(aux_sym_source_file_repeat1, 2, 0, 0), SHIFT_REPEAT(58),
-  [30] = {.entry = {.count = 2, .reusable = true}}, REDUCE(aux_sym_source_file_repeat1, 2, 0, 0), SHIFT_REPEAT(86),
-  [33] = {.entry = {.count = 2, .reusable = true}}, REDUCE(aux_sym_source_file_repeat1, 2, 0, 0), SHIFT_REPEAT(59),
-  [36] = {.entry = {.count = 2, .reusable = true}}, REDUCE(aux_sym_source_file_repeat1, 2, 0, 0), SHIFT_REPEAT(60),
-  [39] = {.entry = {.count = 2, .reusable = true}}, REDUCE(aux_sym_source_file_repeat1, 2, 0, 0), SHIFT_REPEAT(50),
-  [42] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_source_file, 1, 0, 0),
-  [44] = {.entry = {.count = 1, .reusable = true}}, SHIFT(2),
-  [46] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_singleline_entry, 4, 0, 8),
-  [48] = {.entry = {.count = 1, .reusable = true}}, SHIFT(26),
-  [50] = {.entry = {.count = 1, .reusable = true}}, SHIFT(11),
-  [52] = {.entry = {.count = 1, .reusable = false}}, SHIFT(7),
-  [54] = {.entry = {.count = 1, .reusable = true}}, SHIFT(9),
-  [56] = {.entry = {.count = 1, .reusable = true}}, SHIFT(10),
-  [58] = {.entry = {.count = 1, .reusable = true}}, SHIFT(8),
-  [60] = {.entry = {.count = 1, .reusable = true}}, REDUCE(aux_sym_details_repeat1, 2, 0, 19),
-  [62] = {.entry = {.count = 2, .reusable = true}}, REDUCE(aux_sym_details_repeat1, 2, 0, 19), SHIFT_REPEAT(11),
-  [65] = {.entry = {.count = 2, .reusable = false}}, REDUCE(aux_sym_details_repeat1, 2, 0, 19), SHIFT_REPEAT(7),
-  [68] = {.entry = {.count = 2, .reusable = true}}, REDUCE(aux_sym_details_repeat1, 2, 0, 19), SHIFT_REPEAT(9),
-  [71] = {.entry = {.count = 2, .reusable = true}}, REDUCE(aux_sym_details_repeat1, 2, 0, 19), SHIFT_REPEAT(10),
-  [74] = {.entry = {.count = 2, .reusable = true}}, REDUCE(aux_sym_details_repeat1, 2, 0, 19), SHIFT_REPEAT(8),
-  [77] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_details, 1, 0, 16),
-  [79] = {.entry = {.count = 1, .reusable = true}}, REDUCE(aux_sym_details_repeat1, 1, 0, 11),
-  [81] = {.entry = {.count = 1, .reusable = false}}, REDUCE(aux_sym_details_repeat1, 1, 0, 11),
-  [83] = {.entry = {.count = 1, .reusable = true}}, REDUCE(aux_sym_details_repeat1, 1, 0, 14),
-  [85] = {.entry = {.count = 1, .reusable = false}}, REDUCE(aux_sym_details_repeat1, 1, 0, 14),
-  [87] = {.entry = {.count = 1, .reusable = true}}, REDUCE(aux_sym_details_repeat1, 1, 0, 12),
-  [89] = {.entry = {.count = 1, .reusable = false}}, REDUCE(aux_sym_details_repeat1, 1, 0, 12),
-  [91] = {.entry = {.count = 1, .reusable = true}}, REDUCE(aux_sym_details_repeat1, 1, 0, 13),
-  [93] = {.entry = {.count = 1, .reusable = false}}, REDUCE(aux_sym_details_repeat1, 1, 0, 13),
-  [95] = {.entry = {.count = 1, .reusable = true}}, REDUCE(aux_sym_details_repeat1, 1, 0, 10),
-  [97] = {.entry = {.count = 1, .reusable = false}}, REDUCE(aux_sym_details_repeat1, 1, 0, 10),
-  [99] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_weigh_in_entry, 3, 0, 3),
-  [101] = {.entry = {.count = 1, .reusable = true}}, SHIFT(38),
-  [103] = {.entry = {.count = 1, .reusable = true}}, SHIFT(13),
-  [105] = {.entry = {.count = 1, .reusable = true}}, SHIFT(15),
-  [107] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_weigh_in_entry, 4, 0, 5),
-  [109] = {.entry = {.count = 1, .reusable = true}}, SHIFT(22),
-  [111] = {.entry = {.count = 1, .reusable = true}}, SHIFT(23),
-  [113] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_template_block, 6, 0, 4),
-  [115] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_weigh_in_entry, 4, 0, 6),
-  [117] = {.entry = {.count = 1, .reusable = true}}, SHIFT(24),
-  [119] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_query_entry, 4, 0, 7),
-  [121] = {.entry = {.count = 1, .reusable = true}}, SHIFT(25),
-  [123] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_include_directive, 3, 0, 1),
-  [125] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_movement_block, 5, 0, 4),
-  [127] = {.entry = {.count = 1, .reusable = true}}, SHIFT(34),
-  [129] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_template_block, 5, 0, 4),
-  [131] = {.entry = {.count = 1, .reusable = true}}, SHIFT(14),
-  [133] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_weigh_in_entry, 5, 0, 5),
-  [135] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_weigh_in_entry, 5, 0, 9),
-  [137] = {.entry = {.count = 1, .reusable = true}}, SHIFT(36),
-  [139] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_weigh_in_entry, 5, 0, 6),
-  [141] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_query_entry, 5, 0, 7),
-  [143] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_singleline_entry, 5, 0, 8),
-  [145] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_plugin_directive, 3, 0, 1),
-  [147] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_note_entry, 3, 0, 2),
-  [149] = {.entry = {.count = 1, .reusable = true}}, SHIFT(35),
-  [151] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_plugin_directive, 2, 0, 1),
-  [153] = {.entry = {.count = 1, .reusable = true}}, SHIFT(27),
-  [155] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_file_path, 3, 0, 0),
-  [157] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_movement_block, 4, 0, 4),
-  [159] = {.entry = {.count = 1, .reusable = true}}, SHIFT(18),
-  [161] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_singleline_entry, 5, 0, 15),
-  [163] = {.entry = {.count = 1, .reusable = true}}, SHIFT(37),
-  [165] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_template_block, 4, 0, 4),
-  [167] = {.entry = {.count = 1, .reusable = true}}, SHIFT(20),
-  [169] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_movement_block, 6, 0, 4),
-  [171] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_note_entry, 4, 0, 2),
-  [173] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_weigh_in_entry, 6, 0, 9),
-  [175] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_singleline_entry, 6, 0, 15),
-  [177] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_weigh_in_entry, 4, 0, 3),
-  [179] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_session_block, 7, 0, 20),
-  [181] = {.entry = {.count = 1, .reusable = true}}, SHIFT(41),
-  [183] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_include_directive, 2, 0, 1),
-  [185] = {.entry = {.count = 1, .reusable = true}}, SHIFT(17),
-  [187] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_session_block, 8, 0, 20),
-  [189] = {.entry = {.count = 1, .reusable = true}}, SHIFT(43),
-  [191] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_session_block, 9, 0, 20),
-  [193] = {.entry = {.count = 1, .reusable = false}}, SHIFT(42),
-  [195] = {.entry = {.count = 1, .reusable = true}}, SHIFT(84),
-  [197] = {.entry = {.count = 1, .reusable = false}}, SHIFT(75),
-  [199] = {.entry = {.count = 1, .reusable = false}}, SHIFT(21),
-  [201] = {.entry = {.count = 1, .reusable = false}}, SHIFT(33),
-  [203] = {.entry = {.count = 1, .reusable = false}}, SHIFT(39),
-  [205] = {.entry = {.count = 1, .reusable = false}}, REDUCE(aux_sym_session_block_repeat1, 2, 0, 0),
-  [207] = {.entry = {.count = 2, .reusable = true}}, REDUCE(aux_sym_session_block_repeat1, 2, 0, 0), SHIFT_REPEAT(84),
-  [210] = {.entry = {.count = 2, .reusable = false}}, REDUCE(aux_sym_session_block_repeat1, 2, 0, 0), SHIFT_REPEAT(75),
-  [213] = {.entry = {.count = 1, .reusable = true}}, SHIFT(82),
-  [215] = {.entry = {.count = 1, .reusable = true}}, SHIFT(68),
-  [217] = {.entry = {.count = 1, .reusable = true}}, SHIFT(70),
-  [219] = {.entry = {.count = 1, .reusable = true}}, SHIFT(77),
-  [221] = {.entry = {.count = 1, .reusable = false}}, REDUCE(aux_sym_movement_block_repeat1, 2, 0, 0),
-  [223] = {.entry = {.count = 2, .reusable = false}}, REDUCE(aux_sym_movement_block_repeat1, 2, 0, 0), SHIFT_REPEAT(65),
-  [226] = {.entry = {.count = 1, .reusable = false}}, SHIFT(31),
-  [228] = {.entry = {.count = 1, .reusable = false}}, SHIFT(65),
-  [230] = {.entry = {.count = 1, .reusable = false}}, SHIFT(19),
-  [232] = {.entry = {.count = 1, .reusable = false}}, SHIFT(62),
-  [234] = {.entry = {.count = 1, .reusable = true}}, SHIFT(78),
-  [236] = {.entry = {.count = 1, .reusable = false}}, REDUCE(sym_note_line, 3, 0, 18),
-  [238] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_note_line, 3, 0, 18),
-  [240] = {.entry = {.count = 1, .reusable = true}}, SHIFT(90),
-  [242] = {.entry = {.count = 1, .reusable = false}}, REDUCE(sym_item_line, 4, 0, 22),
-  [244] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_item_line, 4, 0, 22),
-  [246] = {.entry = {.count = 1, .reusable = true}}, SHIFT(85),
-  [248] = {.entry = {.count = 1, .reusable = true}}, SHIFT(65),
-  [250] = {.entry = {.count = 1, .reusable = true}}, SHIFT(69),
-  [252] = {.entry = {.count = 1, .reusable = false}}, REDUCE(sym_metadata_line, 3, 0, 17),
-  [254] = {.entry = {.count = 1, .reusable = false}}, REDUCE(sym_metadata_line, 4, 0, 21),
-  [256] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_identifier, 1, 0, 0),
-  [258] = {.entry = {.count = 1, .reusable = true}}, SHIFT(75),
-  [260] = {.entry = {.count = 1, .reusable = true}}, SHIFT(52),
-  [262] = {.entry = {.count = 1, .reusable = true}}, SHIFT(12),
-  [264] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_name, 1, 0, 0),
-  [266] = {.entry = {.count = 1, .reusable = true}}, SHIFT(74),
-  [268] = {.entry = {.count = 1, .reusable = true}}, SHIFT(46),
-  [270] = {.entry = {.count = 1, .reusable = true}},  ACCEPT_INPUT(),
-  [272] = {.entry = {.count = 1, .reusable = true}}, SHIFT(30),
-  [274] = {.entry = {.count = 1, .reusable = true}}, SHIFT(16),
-  [276] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_item, 1, 0, 0),
-  [278] = {.entry = {.count = 1, .reusable = true}}, SHIFT(4),
-  [280] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_flag, 1, 0, 0),
-  [282] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_text_until_newline, 1, 0, 0),
-  [284] = {.entry = {.count = 1, .reusable = true}}, SHIFT(64),
-  [286] = {.entry = {.count = 1, .reusable = true}}, SHIFT(55),
-  [288] = {.entry = {.count = 1, .reusable = true}}, SHIFT(54),
-  [290] = {.entry = {.count = 1, .reusable = true}}, SHIFT(28),
-  [292] = {.entry = {.count = 1, .reusable = true}}, SHIFT(57),
-  [294] = {.entry = {.count = 1, .reusable = true}}, SHIFT(80),
-  [296] = {.entry = {.count = 1, .reusable = true}}, SHIFT(73),
-  [298] = {.entry = {.count = 1, .reusable = true}}, SHIFT(89),
-  [300] = {.entry = {.count = 1, .reusable = true}}, SHIFT(48),
-  [302] = {.entry = {.count = 1, .reusable = true}}, SHIFT(47),
-  [304] = {.entry = {.count = 1, .reusable = true}}, SHIFT(56),
+  [5] = {.entry = {.count = 1, .reusable = true}}, SHIFT(2),
+  [7] = {.entry = {.count = 1, .reusable = true}}, SHIFT(114),
+  [9] = {.entry = {.count = 1, .reusable = true}}, SHIFT(111),
+  [11] = {.entry = {.count = 1, .reusable = true}}, SHIFT(112),
+  [13] = {.entry = {.count = 1, .reusable = true}}, SHIFT(128),
+  [15] = {.entry = {.count = 1, .reusable = true}}, SHIFT(113),
+  [17] = {.entry = {.count = 1, .reusable = true}}, SHIFT(142),
+  [19] = {.entry = {.count = 1, .reusable = true}}, SHIFT(106),
+  [21] = {.entry = {.count = 1, .reusable = true}}, SHIFT(115),
+  [23] = {.entry = {.count = 1, .reusable = true}}, SHIFT(88),
+  [25] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_source_file, 1, 0, 0),
+  [27] = {.entry = {.count = 1, .reusable = true}}, SHIFT(3),
+  [29] = {.entry = {.count = 1, .reusable = true}}, REDUCE(aux_sym_source_file_repeat1, 2, 0, 0),
+  [31] = {.entry = {.count = 2, .reusable = true}}, REDUCE(aux_sym_source_file_repeat1, 2, 0, 0), SHIFT_REPEAT(3),
+  [34] = {.entry = {.count = 2, .reusable = true}}, REDUCE(aux_sym_source_file_repeat1, 2, 0, 0), SHIFT_REPEAT(114),
+  [37] = {.entry = {.count = 2, .reusable = true}}, REDUCE(aux_sym_source_file_repeat1, 2, 0, 0), SHIFT_REPEAT(111),
+  [40] = {.entry = {.count = 2, .reusable = true}}, REDUCE(aux_sym_source_file_repeat1, 2, 0, 0), SHIFT_REPEAT(112),
+  [43] = {.entry = {.count = 2, .reusable = true}}, REDUCE(aux_sym_source_file_repeat1, 2, 0, 0), SHIFT_REPEAT(128),
+  [46] = {.entry = {.count = 2, .reusable = true}}, REDUCE(aux_sym_source_file_repeat1, 2, 0, 0), SHIFT_REPEAT(113),
+  [49] = {.entry = {.count = 2, .reusable = true}}, REDUCE(aux_sym_source_file_repeat1, 2, 0, 0), SHIFT_REPEAT(142),
+  [52] = {.entry = {.count = 2, .reusable = true}}, REDUCE(aux_sym_source_file_repeat1, 2, 0, 0), SHIFT_REPEAT(106),
+  [55] = {.entry = {.count = 2, .reusable = true}}, REDUCE(aux_sym_source_file_repeat1, 2, 0, 0), SHIFT_REPEAT(115),
+  [58] = {.entry = {.count = 2, .reusable = true}}, REDUCE(aux_sym_source_file_repeat1, 2, 0, 0), SHIFT_REPEAT(88),
+  [61] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_singleline_entry, 4, 0, 13),
+  [63] = {.entry = {.count = 1, .reusable = true}}, SHIFT(59),
+  [65] = {.entry = {.count = 1, .reusable = true}}, SHIFT(85),
+  [67] = {.entry = {.count = 1, .reusable = false}}, SHIFT(10),
+  [69] = {.entry = {.count = 1, .reusable = true}}, SHIFT(11),
+  [71] = {.entry = {.count = 1, .reusable = false}}, SHIFT(8),
+  [73] = {.entry = {.count = 1, .reusable = true}}, SHIFT(13),
+  [75] = {.entry = {.count = 1, .reusable = true}}, SHIFT(12),
+  [77] = {.entry = {.count = 1, .reusable = true}}, SHIFT(15),
+  [79] = {.entry = {.count = 1, .reusable = true}}, SHIFT(16),
+  [81] = {.entry = {.count = 1, .reusable = true}}, SHIFT(17),
+  [83] = {.entry = {.count = 1, .reusable = true}}, SHIFT(9),
+  [85] = {.entry = {.count = 1, .reusable = true}}, SHIFT(14),
+  [87] = {.entry = {.count = 1, .reusable = true}}, SHIFT(7),
+  [89] = {.entry = {.count = 1, .reusable = true}}, SHIFT(18),
+  [91] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_details, 1, 0, 29),
+  [93] = {.entry = {.count = 1, .reusable = true}}, REDUCE(aux_sym_details_repeat1, 2, 0, 33),
+  [95] = {.entry = {.count = 2, .reusable = false}}, REDUCE(aux_sym_details_repeat1, 2, 0, 33), SHIFT_REPEAT(10),
+  [98] = {.entry = {.count = 2, .reusable = true}}, REDUCE(aux_sym_details_repeat1, 2, 0, 33), SHIFT_REPEAT(11),
+  [101] = {.entry = {.count = 2, .reusable = false}}, REDUCE(aux_sym_details_repeat1, 2, 0, 33), SHIFT_REPEAT(8),
+  [104] = {.entry = {.count = 2, .reusable = true}}, REDUCE(aux_sym_details_repeat1, 2, 0, 33), SHIFT_REPEAT(13),
+  [107] = {.entry = {.count = 2, .reusable = true}}, REDUCE(aux_sym_details_repeat1, 2, 0, 33), SHIFT_REPEAT(12),
+  [110] = {.entry = {.count = 2, .reusable = true}}, REDUCE(aux_sym_details_repeat1, 2, 0, 33), SHIFT_REPEAT(15),
+  [113] = {.entry = {.count = 2, .reusable = true}}, REDUCE(aux_sym_details_repeat1, 2, 0, 33), SHIFT_REPEAT(16),
+  [116] = {.entry = {.count = 2, .reusable = true}}, REDUCE(aux_sym_details_repeat1, 2, 0, 33), SHIFT_REPEAT(17),
+  [119] = {.entry = {.count = 2, .reusable = true}}, REDUCE(aux_sym_details_repeat1, 2, 0, 33), SHIFT_REPEAT(9),
+  [122] = {.entry = {.count = 2, .reusable = true}}, REDUCE(aux_sym_details_repeat1, 2, 0, 33), SHIFT_REPEAT(14),
+  [125] = {.entry = {.count = 2, .reusable = true}}, REDUCE(aux_sym_details_repeat1, 2, 0, 33), SHIFT_REPEAT(7),
+  [128] = {.entry = {.count = 2, .reusable = true}}, REDUCE(aux_sym_details_repeat1, 2, 0, 33), SHIFT_REPEAT(18),
+  [131] = {.entry = {.count = 1, .reusable = true}}, REDUCE(aux_sym_details_repeat1, 1, 0, 26),
+  [133] = {.entry = {.count = 1, .reusable = false}}, REDUCE(aux_sym_details_repeat1, 1, 0, 26),
+  [135] = {.entry = {.count = 1, .reusable = true}}, REDUCE(aux_sym_details_repeat1, 1, 0, 18),
+  [137] = {.entry = {.count = 1, .reusable = false}}, REDUCE(aux_sym_details_repeat1, 1, 0, 18),
+  [139] = {.entry = {.count = 1, .reusable = true}}, REDUCE(aux_sym_details_repeat1, 1, 0, 24),
+  [141] = {.entry = {.count = 1, .reusable = false}}, REDUCE(aux_sym_details_repeat1, 1, 0, 24),
+  [143] = {.entry = {.count = 1, .reusable = true}}, REDUCE(aux_sym_details_repeat1, 1, 0, 16),
+  [145] = {.entry = {.count = 1, .reusable = false}}, REDUCE(aux_sym_details_repeat1, 1, 0, 16),
+  [147] = {.entry = {.count = 1, .reusable = true}}, REDUCE(aux_sym_details_repeat1, 1, 0, 17),
+  [149] = {.entry = {.count = 1, .reusable = false}}, REDUCE(aux_sym_details_repeat1, 1, 0, 17),
+  [151] = {.entry = {.count = 1, .reusable = true}}, REDUCE(aux_sym_details_repeat1, 1, 0, 20),
+  [153] = {.entry = {.count = 1, .reusable = false}}, REDUCE(aux_sym_details_repeat1, 1, 0, 20),
+  [155] = {.entry = {.count = 1, .reusable = true}}, REDUCE(aux_sym_details_repeat1, 1, 0, 19),
+  [157] = {.entry = {.count = 1, .reusable = false}}, REDUCE(aux_sym_details_repeat1, 1, 0, 19),
+  [159] = {.entry = {.count = 1, .reusable = true}}, REDUCE(aux_sym_details_repeat1, 1, 0, 25),
+  [161] = {.entry = {.count = 1, .reusable = false}}, REDUCE(aux_sym_details_repeat1, 1, 0, 25),
+  [163] = {.entry = {.count = 1, .reusable = true}}, REDUCE(aux_sym_details_repeat1, 1, 0, 21),
+  [165] = {.entry = {.count = 1, .reusable = false}}, REDUCE(aux_sym_details_repeat1, 1, 0, 21),
+  [167] = {.entry = {.count = 1, .reusable = true}}, REDUCE(aux_sym_details_repeat1, 1, 0, 22),
+  [169] = {.entry = {.count = 1, .reusable = false}}, REDUCE(aux_sym_details_repeat1, 1, 0, 22),
+  [171] = {.entry = {.count = 1, .reusable = true}}, REDUCE(aux_sym_details_repeat1, 1, 0, 23),
+  [173] = {.entry = {.count = 1, .reusable = false}}, REDUCE(aux_sym_details_repeat1, 1, 0, 23),
+  [175] = {.entry = {.count = 1, .reusable = true}}, REDUCE(aux_sym_details_repeat1, 1, 0, 27),
+  [177] = {.entry = {.count = 1, .reusable = false}}, REDUCE(aux_sym_details_repeat1, 1, 0, 27),
+  [179] = {.entry = {.count = 1, .reusable = false}}, SHIFT(34),
+  [181] = {.entry = {.count = 1, .reusable = true}}, SHIFT(23),
+  [183] = {.entry = {.count = 1, .reusable = false}}, SHIFT(24),
+  [185] = {.entry = {.count = 1, .reusable = true}}, SHIFT(25),
+  [187] = {.entry = {.count = 1, .reusable = true}}, SHIFT(26),
+  [189] = {.entry = {.count = 1, .reusable = true}}, SHIFT(27),
+  [191] = {.entry = {.count = 1, .reusable = true}}, SHIFT(28),
+  [193] = {.entry = {.count = 1, .reusable = true}}, SHIFT(29),
+  [195] = {.entry = {.count = 1, .reusable = true}}, SHIFT(30),
+  [197] = {.entry = {.count = 1, .reusable = true}}, SHIFT(31),
+  [199] = {.entry = {.count = 1, .reusable = true}}, SHIFT(32),
+  [201] = {.entry = {.count = 1, .reusable = true}}, SHIFT(33),
+  [203] = {.entry = {.count = 2, .reusable = false}}, REDUCE(aux_sym_details_repeat1, 2, 0, 33), SHIFT_REPEAT(34),
+  [206] = {.entry = {.count = 2, .reusable = true}}, REDUCE(aux_sym_details_repeat1, 2, 0, 33), SHIFT_REPEAT(23),
+  [209] = {.entry = {.count = 2, .reusable = false}}, REDUCE(aux_sym_details_repeat1, 2, 0, 33), SHIFT_REPEAT(24),
+  [212] = {.entry = {.count = 2, .reusable = true}}, REDUCE(aux_sym_details_repeat1, 2, 0, 33), SHIFT_REPEAT(25),
+  [215] = {.entry = {.count = 2, .reusable = true}}, REDUCE(aux_sym_details_repeat1, 2, 0, 33), SHIFT_REPEAT(26),
+  [218] = {.entry = {.count = 2, .reusable = true}}, REDUCE(aux_sym_details_repeat1, 2, 0, 33), SHIFT_REPEAT(27),
+  [221] = {.entry = {.count = 2, .reusable = true}}, REDUCE(aux_sym_details_repeat1, 2, 0, 33), SHIFT_REPEAT(28),
+  [224] = {.entry = {.count = 2, .reusable = true}}, REDUCE(aux_sym_details_repeat1, 2, 0, 33), SHIFT_REPEAT(29),
+  [227] = {.entry = {.count = 2, .reusable = true}}, REDUCE(aux_sym_details_repeat1, 2, 0, 33), SHIFT_REPEAT(30),
+  [230] = {.entry = {.count = 2, .reusable = true}}, REDUCE(aux_sym_details_repeat1, 2, 0, 33), SHIFT_REPEAT(31),
+  [233] = {.entry = {.count = 2, .reusable = true}}, REDUCE(aux_sym_details_repeat1, 2, 0, 33), SHIFT_REPEAT(32),
+  [236] = {.entry = {.count = 2, .reusable = true}}, REDUCE(aux_sym_details_repeat1, 2, 0, 33), SHIFT_REPEAT(33),
+  [239] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_weigh_in_entry, 3, 0, 7),
+  [241] = {.entry = {.count = 1, .reusable = true}}, SHIFT(47),
+  [243] = {.entry = {.count = 1, .reusable = true}}, SHIFT(39),
+  [245] = {.entry = {.count = 1, .reusable = true}}, SHIFT(48),
+  [247] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_rest_entry, 2, 0, 4),
+  [249] = {.entry = {.count = 1, .reusable = true}}, SHIFT(60),
+  [251] = {.entry = {.count = 1, .reusable = true}}, SHIFT(41),
+  [253] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_identifier, 1, 0, 0),
+  [255] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_weigh_in_entry, 4, 0, 10),
+  [257] = {.entry = {.count = 1, .reusable = true}}, SHIFT(55),
+  [259] = {.entry = {.count = 1, .reusable = true}}, SHIFT(56),
+  [261] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_include_directive, 2, 0, 1),
+  [263] = {.entry = {.count = 1, .reusable = true}}, SHIFT(79),
+  [265] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_rest_entry, 3, 0, 8),
+  [267] = {.entry = {.count = 1, .reusable = true}}, SHIFT(49),
+  [269] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_file_path, 3, 0, 0),
+  [271] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_goal_directive, 4, 0, 5),
+  [273] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_movement_block, 4, 0, 9),
+  [275] = {.entry = {.count = 1, .reusable = true}}, SHIFT(51),
+  [277] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_template_block, 4, 0, 9),
+  [279] = {.entry = {.count = 1, .reusable = true}}, SHIFT(53),
+  [281] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_note_entry, 4, 0, 6),
+  [283] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_weigh_in_entry, 4, 0, 7),
+  [285] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_weigh_in_entry, 4, 0, 11),
+  [287] = {.entry = {.count = 1, .reusable = true}}, SHIFT(57),
+  [289] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_rest_entry, 4, 0, 8),
+  [291] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_query_entry, 4, 0, 12),
+  [293] = {.entry = {.count = 1, .reusable = true}}, SHIFT(58),
+  [295] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_movement_block, 5, 0, 9),
+  [297] = {.entry = {.count = 1, .reusable = true}}, SHIFT(66),
+  [299] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_template_block, 5, 0, 9),
+  [301] = {.entry = {.count = 1, .reusable = true}}, SHIFT(67),
+  [303] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_weigh_in_entry, 5, 0, 10),
+  [305] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_weigh_in_entry, 5, 0, 14),
+  [307] = {.entry = {.count = 1, .reusable = true}}, SHIFT(68),
+  [309] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_weigh_in_entry, 5, 0, 11),
+  [311] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_query_entry, 5, 0, 12),
+  [313] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_singleline_entry, 5, 0, 13),
+  [315] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_rest_entry, 3, 0, 4),
+  [317] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_plugin_directive, 2, 0, 1),
+  [319] = {.entry = {.count = 1, .reusable = true}}, SHIFT(80),
+  [321] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_units_directive, 2, 0, 2),
+  [323] = {.entry = {.count = 1, .reusable = true}}, SHIFT(81),
+  [325] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_bodyweight_directive, 2, 0, 3),
+  [327] = {.entry = {.count = 1, .reusable = true}}, SHIFT(82),
+  [329] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_singleline_entry, 5, 0, 28),
+  [331] = {.entry = {.count = 1, .reusable = true}}, SHIFT(70),
+  [333] = {.entry = {.count = 1, .reusable = true}}, SHIFT(71),
+  [335] = {.entry = {.count = 1, .reusable = true}}, SHIFT(73),
+  [337] = {.entry = {.count = 1, .reusable = true}}, SHIFT(124),
+  [339] = {.entry = {.count = 1, .reusable = false}}, SHIFT(72),
+  [341] = {.entry = {.count = 1, .reusable = false}}, SHIFT(132),
+  [343] = {.entry = {.count = 1, .reusable = true}}, SHIFT(151),
+  [345] = {.entry = {.count = 1, .reusable = false}}, SHIFT(131),
+  [347] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_movement_block, 6, 0, 9),
+  [349] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_template_block, 6, 0, 9),
+  [351] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_weigh_in_entry, 6, 0, 14),
+  [353] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_singleline_entry, 6, 0, 15),
+  [355] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_singleline_entry, 6, 0, 28),
+  [357] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_singleline_entry, 6, 0, 32),
+  [359] = {.entry = {.count = 1, .reusable = true}}, SHIFT(74),
+  [361] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_session_block, 7, 0, 34),
+  [363] = {.entry = {.count = 1, .reusable = true}}, SHIFT(75),
+  [365] = {.entry = {.count = 1, .reusable = true}}, SHIFT(77),
+  [367] = {.entry = {.count = 1, .reusable = false}}, SHIFT(76),
+  [369] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_singleline_entry, 7, 0, 32),
+  [371] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_session_block, 8, 0, 34),
+  [373] = {.entry = {.count = 1, .reusable = true}}, SHIFT(78),
+  [375] = {.entry = {.count = 2, .reusable = true}}, REDUCE(aux_sym_session_block_repeat1, 2, 0, 0), SHIFT_REPEAT(77),
+  [378] = {.entry = {.count = 2, .reusable = true}}, REDUCE(aux_sym_session_block_repeat1, 2, 0, 0), SHIFT_REPEAT(124),
+  [381] = {.entry = {.count = 1, .reusable = false}}, REDUCE(aux_sym_session_block_repeat1, 2, 0, 0),
+  [383] = {.entry = {.count = 2, .reusable = false}}, REDUCE(aux_sym_session_block_repeat1, 2, 0, 0), SHIFT_REPEAT(132),
+  [386] = {.entry = {.count = 2, .reusable = true}}, REDUCE(aux_sym_session_block_repeat1, 2, 0, 0), SHIFT_REPEAT(151),
+  [389] = {.entry = {.count = 2, .reusable = false}}, REDUCE(aux_sym_session_block_repeat1, 2, 0, 0), SHIFT_REPEAT(131),
+  [392] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_session_block, 9, 0, 34),
+  [394] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_include_directive, 3, 0, 1),
+  [396] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_plugin_directive, 3, 0, 1),
+  [398] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_units_directive, 3, 0, 2),
+  [400] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_bodyweight_directive, 3, 0, 3),
+  [402] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_goal_directive, 3, 0, 5),
+  [404] = {.entry = {.count = 1, .reusable = true}}, SHIFT(43),
+  [406] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_note_entry, 3, 0, 6),
+  [408] = {.entry = {.count = 1, .reusable = true}}, SHIFT(46),
+  [410] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_singleline_entry, 5, 0, 15),
+  [412] = {.entry = {.count = 1, .reusable = true}}, SHIFT(69),
+  [414] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_item_line, 4, 0, 36),
+  [416] = {.entry = {.count = 1, .reusable = false}}, REDUCE(sym_item_line, 4, 0, 36),
+  [418] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_item_line, 5, 0, 37),
+  [420] = {.entry = {.count = 1, .reusable = false}}, REDUCE(sym_item_line, 5, 0, 37),
+  [422] = {.entry = {.count = 1, .reusable = true}}, SHIFT(123),
+  [424] = {.entry = {.count = 1, .reusable = true}}, SHIFT(127),
+  [426] = {.entry = {.count = 1, .reusable = true}}, SHIFT(37),
+  [428] = {.entry = {.count = 1, .reusable = true}}, SHIFT(129),
+  [430] = {.entry = {.count = 1, .reusable = true}}, SHIFT(130),
+  [432] = {.entry = {.count = 1, .reusable = false}}, SHIFT(54),
+  [434] = {.entry = {.count = 1, .reusable = true}}, SHIFT(145),
+  [436] = {.entry = {.count = 1, .reusable = false}}, REDUCE(aux_sym_template_block_repeat1, 2, 0, 0),
+  [438] = {.entry = {.count = 2, .reusable = true}}, REDUCE(aux_sym_template_block_repeat1, 2, 0, 0), SHIFT_REPEAT(145),
+  [441] = {.entry = {.count = 2, .reusable = false}}, REDUCE(aux_sym_template_block_repeat1, 2, 0, 0), SHIFT_REPEAT(131),
+  [444] = {.entry = {.count = 1, .reusable = true}}, REDUCE(aux_sym_session_block_repeat1, 2, 0, 0),
+  [446] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_group_block, 5, 0, 38),
+  [448] = {.entry = {.count = 1, .reusable = false}}, REDUCE(sym_group_block, 5, 0, 38),
+  [450] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_note_line, 3, 0, 31),
+  [452] = {.entry = {.count = 1, .reusable = false}}, REDUCE(sym_note_line, 3, 0, 31),
+  [454] = {.entry = {.count = 1, .reusable = false}}, SHIFT(45),
+  [456] = {.entry = {.count = 1, .reusable = false}}, SHIFT(44),
+  [458] = {.entry = {.count = 1, .reusable = false}}, SHIFT(38),
+  [460] = {.entry = {.count = 1, .reusable = false}}, SHIFT(122),
+  [462] = {.entry = {.count = 1, .reusable = false}}, SHIFT(52),
+  [464] = {.entry = {.count = 1, .reusable = false}}, REDUCE(aux_sym_group_block_repeat1, 2, 0, 0),
+  [466] = {.entry = {.count = 2, .reusable = false}}, REDUCE(aux_sym_group_block_repeat1, 2, 0, 0), SHIFT_REPEAT(131),
+  [469] = {.entry = {.count = 1, .reusable = false}}, REDUCE(aux_sym_movement_block_repeat1, 2, 0, 0),
+  [471] = {.entry = {.count = 2, .reusable = false}}, REDUCE(aux_sym_movement_block_repeat1, 2, 0, 0), SHIFT_REPEAT(38),
+  [474] = {.entry = {.count = 1, .reusable = true}}, SHIFT(131),
+  [476] = {.entry = {.count = 1, .reusable = false}}, SHIFT(109),
+  [478] = {.entry = {.count = 1, .reusable = true}}, SHIFT(149),
+  [480] = {.entry = {.count = 1, .reusable = true}}, SHIFT(126),
+  [482] = {.entry = {.count = 1, .reusable = true}}, SHIFT(38),
+  [484] = {.entry = {.count = 1, .reusable = false}}, REDUCE(sym_metadata_line, 4, 0, 35),
+  [486] = {.entry = {.count = 1, .reusable = true}}, SHIFT(103),
+  [488] = {.entry = {.count = 1, .reusable = true}}, SHIFT(138),
+  [490] = {.entry = {.count = 1, .reusable = false}}, REDUCE(sym_metadata_line, 3, 0, 30),
+  [492] = {.entry = {.count = 1, .reusable = true}}, SHIFT(135),
+  [494] = {.entry = {.count = 1, .reusable = true}}, SHIFT(121),
+  [496] = {.entry = {.count = 1, .reusable = true}}, SHIFT(136),
+  [498] = {.entry = {.count = 1, .reusable = true}}, SHIFT(86),
+  [500] = {.entry = {.count = 1, .reusable = true}}, SHIFT(148),
+  [502] = {.entry = {.count = 1, .reusable = true}}, SHIFT(117),
+  [504] = {.entry = {.count = 1, .reusable = true}}, SHIFT(150),
+  [506] = {.entry = {.count = 1, .reusable = true}}, SHIFT(92),
+  [508] = {.entry = {.count = 1, .reusable = true}}, SHIFT(84),
+  [510] = {.entry = {.count = 1, .reusable = true}}, SHIFT(91),
+  [512] = {.entry = {.count = 1, .reusable = true}}, SHIFT(50),
+  [514] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_flag, 1, 0, 0),
+  [516] = {.entry = {.count = 1, .reusable = true}}, SHIFT(22),
+  [518] = {.entry = {.count = 1, .reusable = true}}, SHIFT(63),
+  [520] = {.entry = {.count = 1, .reusable = true}}, SHIFT(125),
+  [522] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_item, 1, 0, 0),
+  [524] = {.entry = {.count = 1, .reusable = true}}, SHIFT(100),
+  [526] = {.entry = {.count = 1, .reusable = true}}, SHIFT(42),
+  [528] = {.entry = {.count = 1, .reusable = true}}, SHIFT(107),
+  [530] = {.entry = {.count = 1, .reusable = true}}, SHIFT(133),
+  [532] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_name, 1, 0, 0),
+  [534] = {.entry = {.count = 1, .reusable = true}}, SHIFT(83),
+  [536] = {.entry = {.count = 1, .reusable = true}}, SHIFT(102),
+  [538] = {.entry = {.count = 1, .reusable = true}}, SHIFT(94),
+  [540] = {.entry = {.count = 1, .reusable = true}}, SHIFT(21),
+  [542] = {.entry = {.count = 1, .reusable = true}}, SHIFT(4),
+  [544] = {.entry = {.count = 1, .reusable = true}}, SHIFT(156),
+  [546] = {.entry = {.count = 1, .reusable = true}}, SHIFT(105),
+  [548] = {.entry = {.count = 1, .reusable = true}}, SHIFT(101),
+  [550] = {.entry = {.count = 1, .reusable = true}}, SHIFT(143),
+  [552] = {.entry = {.count = 1, .reusable = true}}, SHIFT(93),
+  [554] = {.entry = {.count = 1, .reusable = true}}, SHIFT(95),
+  [556] = {.entry = {.count = 1, .reusable = true}}, SHIFT(87),
+  [558] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_text_until_newline, 1, 0, 0),
+  [560] = {.entry = {.count = 1, .reusable = true}}, SHIFT(118),
+  [562] = {.entry = {.count = 1, .reusable = true}}, SHIFT(146),
+  [564] = {.entry = {.count = 1, .reusable = true}},  ACCEPT_INPUT(),
+  [566] = {.entry = {.count = 1, .reusable = true}}, SHIFT(65),
+  [568] = {.entry = {.count = 1, .reusable = true}}, SHIFT(35),
+  [570] = {.entry = {.count = 1, .reusable = true}}, SHIFT(36),
+  [572] = {.entry = {.count = 1, .reusable = true}}, SHIFT(104),
 };
 
 #ifdef __cplusplus